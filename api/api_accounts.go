@@ -2,7 +2,8 @@ package api
 
 import (
 	"context"
-	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -42,6 +43,33 @@ type (
 		// Delegate is the delegate name of the account
 		Delegate *Delegate `json:"delegate,omitempty"`
 	}
+
+	// MultisignatureGroupResponse is the API response for multisignature group requests
+	MultisignatureGroupResponse struct {
+		// Groups are the results
+		Groups []*MultisignatureGroup `json:"data"`
+		*GenericResponse
+	}
+
+	// MultisignatureGroup is a multisignature account and its members
+	MultisignatureGroup struct {
+		// Address of the multisignature account
+		Address string `json:"address"`
+		// PublicKey of the multisignature account
+		PublicKey string `json:"publicKey"`
+		// SecondPublicKey of the multisignature account
+		SecondPublicKey string `json:"secondPublicKey"`
+		// Balance of the multisignature account
+		Balance int64 `json:"balance,string"`
+		// UnconfirmedBalance of the multisignature account
+		UnconfirmedBalance int64 `json:"unconfirmedBalance,string"`
+		// Min is the minimum number of signatures required
+		Min int `json:"min"`
+		// Lifetime is the lifetime of pending transactions in hours
+		Lifetime int `json:"lifetime"`
+		// Members are the accounts that can sign for the multisignature account
+		Members []*Account `json:"members"`
+	}
 )
 
 // GetAccounts searches for accounts on the blockchain.
@@ -65,29 +93,35 @@ func (c *Client) GetAccounts(ctx context.Context, options *AccountRequest) (*Acc
 			req.SetQueryParam("username", options.Username)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
+		setListOptions(req, options.ListOptions)
+	}
 
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
+	req.SetResult(&AccountResponse{})
+	req.SetError(Error{})
 
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+	res, err := c.execute(req, resty.MethodGet, "api/accounts")
+	if err != nil {
+		return nil, err
 	}
 
-	req.SetResult(&AccountResponse{})
+	return res.Result().(*AccountResponse), nil
+}
+
+// GetMultisignatureGroups returns the multisignature groups of the account with the given address.
+func (c *Client) GetMultisignatureGroups(ctx context.Context, address string) (*MultisignatureGroupResponse, error) {
+	req := c.restClient.R().SetContext(ctx)
+
+	req.SetPathParams(map[string]string{
+		"address": address,
+	})
+
+	req.SetResult(&MultisignatureGroupResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/accounts")
+	res, err := c.execute(req, resty.MethodGet, "api/accounts/{address}/multisignature_groups")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*AccountResponse), res.Error().(error)
+	return res.Result().(*MultisignatureGroupResponse), nil
 }
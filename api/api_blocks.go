@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -79,29 +81,16 @@ func (c *Client) GetBlocks(ctx context.Context, options *BlockRequest) (*BlockRe
 			req.SetQueryParam("generatorPublicKey", options.GeneratorPublicKey)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&BlockResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/blocks")
+	res, err := c.execute(req, resty.MethodGet, "api/blocks")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*BlockResponse), res.Error().(error)
+	return res.Result().(*BlockResponse), nil
 }
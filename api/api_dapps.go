@@ -2,7 +2,8 @@ package api
 
 import (
 	"context"
-	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -58,29 +59,16 @@ func (c *Client) GetDapps(ctx context.Context, options *DappRequest) (*DappRespo
 			req.SetQueryParam("name", options.Name)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&DappResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/dapps")
+	res, err := c.execute(req, resty.MethodGet, "api/dapps")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*DappResponse), res.Error().(error)
+	return res.Result().(*DappResponse), nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -151,7 +153,7 @@ func (c *Client) GetDelegate(ctx context.Context, options *DelegateRequest) (*De
 	req.SetResult(&DelegatesResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/delegates")
+	res, err := c.execute(req, resty.MethodGet, "api/delegates")
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +168,7 @@ func (c *Client) GetDelegate(ctx context.Context, options *DelegateRequest) (*De
 		GenericResponse: res.Result().(*DelegatesResponse).GenericResponse,
 	}
 
-	return result, res.Error().(error)
+	return result, nil
 
 }
 
@@ -181,31 +183,18 @@ func (c *Client) SearchDelegates(ctx context.Context, username string, listOptio
 	}
 
 	if listOptions != nil {
-		if listOptions.Limit <= 0 {
-			listOptions.Limit = 100
-		}
-
-		if listOptions.Offset < 0 {
-			listOptions.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(listOptions.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(listOptions.Offset))
-
-		if listOptions.Sort != "" {
-			req.SetQueryParam("sort", string(listOptions.Sort))
-		}
+		setListOptions(req, *listOptions)
 	}
 
 	req.SetResult(&DelegatesResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/dapps")
+	res, err := c.execute(req, resty.MethodGet, "api/delegates")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*DelegatesResponse), res.Error().(error)
+	return res.Result().(*DelegatesResponse), nil
 }
 
 // GetNextForgers returns the next forging delegates.
@@ -215,30 +204,17 @@ func (c *Client) GetNextForgers(ctx context.Context, listOptions *ListOptions) (
 	req := c.restClient.R().SetContext(ctx)
 
 	if listOptions != nil {
-		if listOptions.Limit <= 0 {
-			listOptions.Limit = 100
-		}
-
-		if listOptions.Offset < 0 {
-			listOptions.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(listOptions.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(listOptions.Offset))
-
-		if listOptions.Sort != "" {
-			req.SetQueryParam("sort", string(listOptions.Sort))
-		}
+		setListOptions(req, *listOptions)
 	}
 	req.SetResult(&NextForgersResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/delegates/forgers")
+	res, err := c.execute(req, resty.MethodGet, "api/delegates/forgers")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*NextForgersResponse), res.Error().(error)
+	return res.Result().(*NextForgersResponse), nil
 }
 
 // GetForgingStats returns the forgingStats for a delegate.
@@ -266,10 +242,10 @@ func (c *Client) GetForgingStats(ctx context.Context, options *ForgingStatsReque
 	req.SetResult(&ForgingStatsResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/delegates/{address}/forging_statistics")
+	res, err := c.execute(req, resty.MethodGet, "api/delegates/{address}/forging_statistics")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*ForgingStatsResponse), res.Error().(error)
+	return res.Result().(*ForgingStatsResponse), nil
 }
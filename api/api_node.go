@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"time"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -118,8 +120,12 @@ type (
 
 	// ForgingToggleRequest is the request body to toggle forging on a node
 	ForgingToggleRequest struct {
+		// DecryptionKey is the password of the encrypted passphrase in the config of the node
 		DecryptionKey string `json:"decryptionKey"`
-		PublicKey     string `json:"publicKey"`
+		// PublicKey of the delegate
+		PublicKey string `json:"publicKey"`
+		// Forging sets the forging status instead of toggling it. It's only supported by Lisk Core 1.1 and newer.
+		Forging *bool `json:"forging,omitempty"`
 	}
 
 	// ForgingToggleResponse is the API response for forging toggle requests
@@ -136,12 +142,12 @@ func (c *Client) GetConstants(ctx context.Context) (*ConstantsResponse, error) {
 	req.SetResult(&ConstantsResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/node/constants")
+	res, err := c.execute(req, resty.MethodGet, "api/node/constants")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*ConstantsResponse), res.Error().(error)
+	return res.Result().(*ConstantsResponse), nil
 }
 
 // GetNodeStatus returns the status of the node.
@@ -151,57 +157,60 @@ func (c *Client) GetNodeStatus(ctx context.Context) (*NodeStatusReponse, error)
 	req.SetResult(&NodeStatusReponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/node/status")
+	res, err := c.execute(req, resty.MethodGet, "api/node/status")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*NodeStatusReponse), res.Error().(error)
+	return res.Result().(*NodeStatusReponse), nil
 }
 
 // GetForgingStatus returns the forging status of the node.
 // You can optionally specify a publicKey to query for to only return the forging status for that delegate.
-func (c *Client) GetForgingStatus(ctx context.Context, options *ForgingStatusRequest) (*ForgingStatsResponse, error) {
+func (c *Client) GetForgingStatus(ctx context.Context, options *ForgingStatusRequest) (*ForgingStatusResponse, error) {
 	req := c.restClient.R().SetContext(ctx)
 
 	if options != nil && options.PublicKey != "" {
 		req.SetQueryParam("publicKey", options.PublicKey)
 	}
 
-	req.SetResult(&ForgingStatsResponse{})
+	req.SetResult(&ForgingStatusResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/node/status/forging")
+	res, err := c.execute(req, resty.MethodGet, "api/node/status/forging")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*ForgingStatsResponse), res.Error().(error)
+	return res.Result().(*ForgingStatusResponse), nil
 }
 
 // ToggleForging toggles forging on a specific key.
+// Forging can only be toggled on nodes which whitelist the client, so the client should be bound to a single node.
 func (c *Client) ToggleForging(ctx context.Context, options *ForgingToggleRequest) (*ForgingToggleResponse, error) {
 	req := c.restClient.R().SetContext(ctx)
 
 	req.SetBody(options)
 
-	req.SetResult(&ForgingStatsResponse{})
+	req.SetResult(&ForgingStatusResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Put("api/node/status/forging")
+	res, err := c.execute(req, resty.MethodPut, "api/node/status/forging")
 	if err != nil {
 		return nil, err
 	}
 
+	statusResponse := res.Result().(*ForgingStatusResponse)
+
 	var forgingStatus *ForgingStatus
-	if len(res.Result().(*ForgingStatusResponse).ForgingStatus) != 0 {
-		forgingStatus = res.Result().(*ForgingStatusResponse).ForgingStatus[0]
+	if len(statusResponse.ForgingStatus) != 0 {
+		forgingStatus = statusResponse.ForgingStatus[0]
 	}
 
 	result := &ForgingToggleResponse{
 		ForgingStatus:   forgingStatus,
-		GenericResponse: res.Result().(*DelegatesResponse).GenericResponse,
+		GenericResponse: statusResponse.GenericResponse,
 	}
 
-	return result, res.Error().(error)
+	return result, nil
 }
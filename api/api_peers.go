@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -90,29 +92,16 @@ func (c *Client) GetPeers(ctx context.Context, options *PeerRequest) (*PeerRespo
 			req.SetQueryParam("broadhash", options.Broadhash)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&PeerResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/peers")
+	res, err := c.execute(req, resty.MethodGet, "api/peers")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*PeerResponse), res.Error().(error)
+	return res.Result().(*PeerResponse), nil
 }
@@ -2,8 +2,14 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
+
+	"github.com/go-resty/resty"
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/transactions"
 )
 
 type (
@@ -66,8 +72,8 @@ type (
 		Multisignatures []string `json:"signatures"`
 		// Confirmations of the transaction
 		Confirmations int `json:"confirmations"`
-		// Asset of the transaction
-		Asset struct{} `json:"asset"`
+		// Asset of the transaction. The concrete type depends on the transaction type.
+		Asset transactions.Asset `json:"asset"`
 		// ReceivedAt timestamp of the transaction
 		ReceivedAt time.Time `json:"receivedAt"`
 		// Relays is the number of times the transaction was relayed
@@ -89,6 +95,59 @@ const (
 	TransactionStateUnsigned TransactionState = "unsigned"
 )
 
+// UnmarshalJSON unmarshals the transaction and decodes the asset according to the transaction type
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	type transactionWrapper Transaction
+	payload := &struct {
+		*transactionWrapper
+		Asset json.RawMessage `json:"asset"`
+	}{
+		transactionWrapper: (*transactionWrapper)(t),
+	}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	asset, err := transactions.UnmarshalAsset(transactions.TransactionType(t.Type), payload.Asset)
+	if err != nil {
+		return fmt.Errorf("invalid asset of transaction %s: %v", t.ID, err)
+	}
+	t.Asset = asset
+
+	return nil
+}
+
+// ToTransaction converts the transaction to a transactions.Transaction.
+// The ID and sender address are recomputed and an error is returned if they don't match the ones reported by the node.
+// The signatures of the result can be checked using its verification methods.
+func (t *Transaction) ToTransaction() (*transactions.Transaction, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &transactions.Transaction{}
+	if err := transaction.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("cannot convert transaction %s: %v", t.ID, err)
+	}
+
+	id, err := transaction.ID()
+	if err != nil {
+		return nil, err
+	}
+	if id != t.ID {
+		return nil, fmt.Errorf("recomputed id %s does not match the reported id %s", id, t.ID)
+	}
+
+	if t.SenderID != "" {
+		if senderID := crypto.GetAddressFromPublicKey(transaction.SenderPublicKey); senderID != t.SenderID {
+			return nil, fmt.Errorf("sender address %s does not match the reported senderId %s", senderID, t.SenderID)
+		}
+	}
+
+	return transaction, nil
+}
+
 // GetPendingTransactions searches for transactions with the given state.
 // Search parameters can be specified in options.
 // Limit is set to 100 by default
@@ -118,29 +177,16 @@ func (c *Client) GetPendingTransactions(ctx context.Context, state TransactionSt
 			req.SetQueryParam("type", strconv.Itoa(*options.Type))
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&QueueResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/node/transactions/{state}")
+	res, err := c.execute(req, resty.MethodGet, "api/node/transactions/{state}")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*QueueResponse), res.Error().(error)
+	return res.Result().(*QueueResponse), nil
 }
@@ -2,11 +2,26 @@ package api
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
 
+	"github.com/go-resty/resty"
+	"github.com/liskascend/lisk-go/crypto"
 	"github.com/liskascend/lisk-go/transactions"
 )
 
 type (
+	// Signature is the signature of a member of a multisignature account for a pending transaction
+	Signature struct {
+		// TransactionID is the ID of the signed transaction
+		TransactionID string `json:"transactionId"`
+		// PublicKey is the public key of the signing member
+		PublicKey string `json:"publicKey"`
+		// Signature is the signature of the member
+		Signature string `json:"signature"`
+	}
+
 	// SignatureSendResponse is the API response for signature requests
 	SignatureSendResponse struct {
 		Result struct {
@@ -16,19 +31,153 @@ type (
 	}
 )
 
+// NewSignature signs the pending transaction as a member of a multisignature account using the given signer
+// and returns the signature that can be submitted using SendSignature.
+func NewSignature(transaction *transactions.Transaction, signer crypto.Signer) (*Signature, error) {
+	id, err := transaction.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := transaction.Multisign(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signature{
+		TransactionID: id,
+		PublicKey:     hex.EncodeToString(signer.PublicKey()),
+		Signature:     hex.EncodeToString(signature),
+	}, nil
+}
+
 // SendSignature submits the signature for a multisignature transaction to the network.
-func (c *Client) SendSignature(ctx context.Context, transaction *transactions.Transaction) (*TransactionSendResponse, error) {
+func (c *Client) SendSignature(ctx context.Context, signature *Signature) (*SignatureSendResponse, error) {
+	if signature == nil {
+		return nil, errors.New("signature must not be nil")
+	}
+
 	req := c.restClient.R().SetContext(ctx)
 
-	req.SetBody(transaction)
+	req.SetBody(signature)
 
-	req.SetResult(&TransactionSendResponse{})
+	req.SetResult(&SignatureSendResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Post("api/signatures")
+	res, err := c.execute(req, resty.MethodPost, "api/signatures")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*TransactionSendResponse), res.Error().(error)
+	return res.Result().(*SignatureSendResponse), nil
+}
+
+// SignPendingTransactions fetches the transactions that are missing signatures and signs the ones that belong to
+// multisignature accounts of which the given signers are members. Transactions already signed by a member are skipped.
+// The signatures are submitted to the network and returned.
+// A transaction that cannot be signed or whose signature cannot be sent doesn't stop the other transactions from
+// being signed. Their errors are returned as TransactionErrors together with the signatures that were sent.
+// Search parameters for the pending transactions can be specified in options.
+func (c *Client) SignPendingTransactions(ctx context.Context, signers []crypto.Signer, options *QueueRequest) (
+	[]*Signature, error) {
+	res, err := c.GetPendingTransactions(ctx, TransactionStateUnsigned, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the keysgroups of the multisignature accounts by address
+	keysgroups := make(map[string][]string)
+
+	var signatures []*Signature
+	var errs TransactionErrors
+	for _, pending := range res.Transactions {
+		transaction, err := pending.ToTransaction()
+		if err != nil {
+			errs.add(pending.ID, err)
+			continue
+		}
+
+		keysgroup, err := c.getKeysgroup(ctx, pending, transaction, keysgroups)
+		if err != nil {
+			errs.add(pending.ID, err)
+			continue
+		}
+
+		for _, signer := range signers {
+			publicKey := hex.EncodeToString(signer.PublicKey())
+			if !containsString(keysgroup, publicKey) || isMultisignedBy(transaction, signer.PublicKey()) {
+				continue
+			}
+
+			signature, err := NewSignature(transaction, signer)
+			if err != nil {
+				errs.add(pending.ID, fmt.Errorf("cannot sign: %v", err))
+				continue
+			}
+
+			if _, err := c.SendSignature(ctx, signature); err != nil {
+				errs.add(pending.ID, fmt.Errorf("cannot send signature of %s: %v", publicKey, err))
+				continue
+			}
+
+			signatures = append(signatures, signature)
+		}
+	}
+
+	if len(errs) > 0 {
+		return signatures, errs
+	}
+	return signatures, nil
+}
+
+// getKeysgroup returns the public keys of the members that have to sign the pending transaction.
+// For multisignature registrations these are the keys that are added, otherwise the members of the sender account.
+func (c *Client) getKeysgroup(ctx context.Context, pending *Transaction, transaction *transactions.Transaction,
+	keysgroups map[string][]string) ([]string, error) {
+	if asset, ok := transaction.Asset.(*transactions.RegisterMultisignatureAccountAsset); ok {
+		var keysgroup []string
+		for _, key := range asset.AddKeys {
+			keysgroup = append(keysgroup, hex.EncodeToString(key))
+		}
+		return keysgroup, nil
+	}
+
+	if keysgroup, ok := keysgroups[pending.SenderID]; ok {
+		return keysgroup, nil
+	}
+
+	res, err := c.GetMultisignatureGroups(ctx, pending.SenderID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get multisignature group of %s: %v", pending.SenderID, err)
+	}
+
+	var keysgroup []string
+	for _, group := range res.Groups {
+		for _, member := range group.Members {
+			keysgroup = append(keysgroup, member.PublicKey)
+		}
+	}
+	keysgroups[pending.SenderID] = keysgroup
+
+	return keysgroup, nil
+}
+
+// isMultisignedBy returns whether the transaction already contains a signature of the given member
+func isMultisignedBy(transaction *transactions.Transaction, publicKey []byte) bool {
+	for _, signature := range transaction.Signatures() {
+		if valid, _ := transaction.VerifyMultisignature(publicKey, signature); valid {
+			return true
+		}
+	}
+	return false
+}
+
+// containsString returns whether the slice contains the item
+func containsString(data []string, item string) bool {
+	for _, value := range data {
+		if value == item {
+			return true
+		}
+	}
+	return false
 }
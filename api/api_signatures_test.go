@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+// testPendingTransaction returns a transfer of the multisignature account as reported by the node
+func testPendingTransaction(t *testing.T, amount uint64) *Transaction {
+	transaction, err := transactions.NewTransaction(network.Testnet, "104666L", amount,
+		crypto.NewPassphraseSigner("multisignature account"), nil, 0)
+	if err != nil {
+		t.Fatalf("cannot create transaction: %v", err)
+	}
+
+	data, _ := json.Marshal(transaction)
+	pending := &Transaction{}
+	if err := json.Unmarshal(data, pending); err != nil {
+		t.Fatalf("cannot convert transaction: %v", err)
+	}
+	return pending
+}
+
+func TestClient_SignPendingTransactionsContinuesOnErrors(t *testing.T) {
+	member := crypto.NewPassphraseSigner("member")
+
+	failing := testPendingTransaction(t, 1)
+	invalid := testPendingTransaction(t, 2)
+	invalid.ID = "1"
+	signed := testPendingTransaction(t, 3)
+
+	server := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/transactions/unsigned": testData([]*Transaction{failing, invalid, signed}),
+		"/api/accounts/" + failing.SenderID + "/multisignature_groups": testData([]*MultisignatureGroup{
+			{Members: []*Account{{PublicKey: hex.EncodeToString(member.PublicKey())}}},
+		}),
+		"/api/signatures": func(r *http.Request) (interface{}, int) {
+			signature := &Signature{}
+			json.NewDecoder(r.Body).Decode(signature)
+			if signature.TransactionID == failing.ID {
+				return nil, http.StatusConflict
+			}
+			return map[string]string{"message": "Signature Accepted"}, http.StatusOK
+		},
+	})
+	defer server.Close()
+
+	signatures, err := testClient(server).SignPendingTransactions(context.Background(),
+		[]crypto.Signer{member}, nil)
+
+	if len(signatures) != 1 || signatures[0].TransactionID != signed.ID {
+		t.Errorf("Client.SignPendingTransactions() returns signatures %v; want signature of %s", signatures,
+			signed.ID)
+	}
+
+	errs, ok := err.(TransactionErrors)
+	if !ok || len(errs) != 2 || errs[0].TransactionID != failing.ID || errs[1].TransactionID != invalid.ID {
+		t.Errorf("Client.SignPendingTransactions() returns error %v; want errors of %s and %s", err, failing.ID,
+			invalid.ID)
+	}
+}
@@ -2,8 +2,10 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
+	"github.com/go-resty/resty"
 	"github.com/liskascend/lisk-go/transactions"
 )
 
@@ -94,48 +96,45 @@ func (c *Client) GetTransactions(ctx context.Context, options *TransactionReques
 			req.SetQueryParam("maxAmount", strconv.FormatInt(*options.MaxAmount, 10))
 		}
 
-		if options.FromTimestamp <= 0 {
-			options.FromTimestamp = 0
+		fromTimestamp := options.FromTimestamp
+		if fromTimestamp < 0 {
+			fromTimestamp = 0
 		}
 
-		if options.ToTimestamp <= 0 {
-			options.ToTimestamp = 0
-		}
-
-		req.SetQueryParam("fromTimestamp", strconv.FormatInt(options.FromTimestamp, 10))
-		if options.ToTimestamp != 0 {
+		req.SetQueryParam("fromTimestamp", strconv.FormatInt(fromTimestamp, 10))
+		if options.ToTimestamp > 0 {
 			req.SetQueryParam("toTimestamp", strconv.FormatInt(options.ToTimestamp, 10))
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&TransactionsResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/transactions")
+	res, err := c.execute(req, resty.MethodGet, "api/transactions")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*TransactionsResponse), res.Error().(error)
+	return res.Result().(*TransactionsResponse), nil
 }
 
 // SendTransaction submits the transaction to the network.
+// An error is returned if the transaction was created for another network than the one of the client or, if the
+// client is bound to a network, for no network at all.
 func (c *Client) SendTransaction(ctx context.Context, transaction *transactions.Transaction) (*TransactionSendResponse, error) {
+	if c.config.Network != nil {
+		if transaction.Network == nil {
+			return nil, fmt.Errorf("transaction has no network but the client is connected to %s",
+				c.config.Network.Name)
+		}
+		if !transaction.Network.IsSameNetwork(c.config.Network) {
+			return nil, fmt.Errorf("transaction was created for network %s but the client is connected to %s",
+				transaction.Network.Name, c.config.Network.Name)
+		}
+	}
+
 	req := c.restClient.R().SetContext(ctx)
 
 	req.SetBody(transaction)
@@ -143,10 +142,10 @@ func (c *Client) SendTransaction(ctx context.Context, transaction *transactions.
 	req.SetResult(&TransactionSendResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Post("api/transactions")
+	res, err := c.execute(req, resty.MethodPost, "api/transactions")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*TransactionSendResponse), res.Error().(error)
+	return res.Result().(*TransactionSendResponse), nil
 }
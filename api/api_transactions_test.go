@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+func TestClient_SendTransactionChecksNetwork(t *testing.T) {
+	var sent int
+	server := newTestAPIServer(t, testAPIRoutes{
+		"/api/transactions": func(r *http.Request) (interface{}, int) {
+			sent++
+			return map[string]string{"message": "Transaction(s) accepted"}, http.StatusOK
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	client.config.Network = network.Testnet
+
+	tests := []struct {
+		name    string
+		network *network.Network
+		valid   bool
+	}{
+		{name: "same network", network: network.Testnet, valid: true},
+		{name: "other network", network: network.Mainnet},
+		{name: "no network"},
+	}
+
+	for _, test := range tests {
+		transaction, err := transactions.NewTransaction(network.Testnet, "104666L", 1, preflightSigner, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transaction.Network = test.network
+
+		before := sent
+		_, err = client.SendTransaction(context.Background(), transaction)
+		if (err == nil) != test.valid || (sent > before) != test.valid {
+			t.Errorf("%s: Client.SendTransaction() returns %v and sent %d requests; want valid %v", test.name, err,
+				sent-before, test.valid)
+		}
+	}
+}
@@ -2,7 +2,8 @@ package api
 
 import (
 	"context"
-	"strconv"
+
+	"github.com/go-resty/resty"
 )
 
 type (
@@ -53,13 +54,13 @@ type (
 		// PublicKey of the voter
 		PublicKey string `json:"publicKey"`
 		// Balance of the voter
-		Balance int64 `json:"balance"`
+		Balance int64 `json:"balance,string"`
 	}
 
 	// VotesResponse is the API response for voter requests
 	VotesResponse struct {
 		// VoteData is the result
-		VoteData VotesData
+		VoteData VotesData `json:"data"`
 		*GenericResponse
 	}
 
@@ -68,7 +69,7 @@ type (
 		// Address of the voter
 		Address string `json:"address"`
 		// Balance of the voter
-		Balance int64 `json:"balance"`
+		Balance int64 `json:"balance,string"`
 		// Username of the voter
 		Username string `json:"username"`
 		// PublicKey of the voter
@@ -78,16 +79,19 @@ type (
 		// VotesAvailable is the number of votes available
 		VotesAvailable int `json:"votesAvailable"`
 		// Votes are the votes of the voter
-		Votes []struct {
-			// Address of the delegate
-			Address string `json:"address"`
-			// PublicKey of the delegate
-			PublicKey string `json:"publicKey"`
-			// Balance of the delegate
-			Balance int64 `json:"balance"`
-			// Username of the delegate
-			Username string `json:"username"`
-		} `json:"votes"`
+		Votes []*Vote `json:"votes"`
+	}
+
+	// Vote is a vote for a delegate
+	Vote struct {
+		// Address of the delegate
+		Address string `json:"address"`
+		// PublicKey of the delegate
+		PublicKey string `json:"publicKey"`
+		// Balance of the delegate
+		Balance int64 `json:"balance,string"`
+		// Username of the delegate
+		Username string `json:"username"`
 	}
 )
 
@@ -111,31 +115,18 @@ func (c *Client) GetDelegateVoters(ctx context.Context, options *DelegateVoterRe
 			req.SetQueryParam("secondPublicKey", options.SecondPublicKey)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&DelegateVoterResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/voters")
+	res, err := c.execute(req, resty.MethodGet, "api/voters")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*DelegateVoterResponse), res.Error().(error)
+	return res.Result().(*DelegateVoterResponse), nil
 }
 
 // GetVotes returns the votes that a specific address has casted.
@@ -158,29 +149,16 @@ func (c *Client) GetVotes(ctx context.Context, options *VoterRequest) (*VotesRes
 			req.SetQueryParam("secondPublicKey", options.SecondPublicKey)
 		}
 
-		if options.Limit <= 0 {
-			options.Limit = 100
-		}
-
-		if options.Offset < 0 {
-			options.Offset = 0
-		}
-
-		req.SetQueryParam("limit", strconv.Itoa(options.Limit))
-		req.SetQueryParam("offset", strconv.Itoa(options.Offset))
-
-		if options.Sort != "" {
-			req.SetQueryParam("sort", string(options.Sort))
-		}
+		setListOptions(req, options.ListOptions)
 	}
 
 	req.SetResult(&VotesResponse{})
 	req.SetError(Error{})
 
-	res, err := req.Get("api/votes")
+	res, err := c.execute(req, resty.MethodGet, "api/votes")
 	if err != nil {
 		return nil, err
 	}
 
-	return res.Result().(*VotesResponse), res.Error().(error)
+	return res.Result().(*VotesResponse), nil
 }
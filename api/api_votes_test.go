@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// votesPayload is a response of GET /api/votes of Lisk Core 1.0
+const votesPayload = `{
+  "meta": {"offset": 0, "limit": 10},
+  "data": {
+    "address": "2345010215502939232L",
+    "balance": "1081560729258",
+    "username": "",
+    "publicKey": "e0f1c6cca365cd61bbb01cfb454828a698fa4b7170e85a597dde510567f9dda5",
+    "votesUsed": 2,
+    "votesAvailable": 99,
+    "votes": [
+      {
+        "address": "2581762640681118072L",
+        "publicKey": "01389197bbaf1afb0acd47bbfeabb34aca80fb372a8f694a1c0716b3398db746",
+        "balance": "0",
+        "username": "genesis_51"
+      },
+      {
+        "address": "5311041413563211307L",
+        "publicKey": "1e82c7db09da2010e7f5fef24d83bc46238a20ef7ecdf12d9f32e4318a818777",
+        "balance": "84782500000",
+        "username": "genesis_100"
+      }
+    ]
+  },
+  "links": {}
+}`
+
+func TestClient_GetVotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/votes" || r.URL.Query().Get("address") != "2345010215502939232L" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(votesPayload))
+	}))
+	defer server.Close()
+
+	res, err := testClient(server).GetVotes(context.Background(), &VoterRequest{Address: "2345010215502939232L"})
+	if err != nil {
+		t.Fatalf("Client.GetVotes() returns error: %v", err)
+	}
+
+	data := res.VoteData
+	if data.Balance != 1081560729258 || data.VotesUsed != 2 || data.VotesAvailable != 99 || len(data.Votes) != 2 {
+		t.Fatalf("Client.GetVotes() returns %+v; want the votes of the payload", data)
+	}
+
+	vote := data.Votes[1]
+	if vote.Balance != 84782500000 || vote.Username != "genesis_100" || vote.Address != "5311041413563211307L" ||
+		vote.PublicKey != "1e82c7db09da2010e7f5fef24d83bc46238a20ef7ecdf12d9f32e4318a818777" {
+		t.Errorf("Client.GetVotes() returns vote %+v; want genesis_100", vote)
+	}
+}
@@ -1,7 +1,13 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/go-resty/resty"
+	"github.com/liskascend/lisk-go/network"
 )
 
 type (
@@ -9,6 +15,10 @@ type (
 	Client struct {
 		restClient *resty.Client
 		config     *Config
+		pool       *hostPool
+
+		mu   sync.RWMutex
+		host Host
 	}
 )
 
@@ -17,32 +27,243 @@ func NewClient() *Client {
 	return NewClientWithCustomConfig(DefaultConfig)
 }
 
+// NewClientForNetwork returns a new client for the Lisk API of the network which connects to its seed nodes
+func NewClientForNetwork(net *network.Network) *Client {
+	return NewClientWithCustomConfig(NewConfigForNetwork(net))
+}
+
 // NewClientWithCustomConfig returns a new client for the Lisk API and uses a custom config
 func NewClientWithCustomConfig(config *Config) *Client {
 	restClient := resty.New()
 	restClient.SetDebug(config.Debug)
 
-	var hostURL string
+	if config.Timeout > 0 {
+		restClient.SetTimeout(config.Timeout)
+	}
+
+	var host Host
+	var poolHosts []Host
 
 	if config.RandomHost {
-		hostURL = config.GetRandomHost().GetHostURL()
+		host = config.GetRandomHost()
+		poolHosts = config.RandomHostsPool
 	} else {
-		hostURL = config.Host.GetHostURL()
+		host = config.Host
+		poolHosts = []Host{config.Host}
 	}
-	restClient.SetHostURL(hostURL)
+	restClient.SetHostURL(host.GetHostURL())
 
 	return &Client{
 		restClient: restClient,
 		config:     config,
+		pool:       newHostPool(poolHosts, config.getEjectionTime()),
+		host:       host,
+	}
+}
+
+// Network returns the network of the client. It's nil if the client is not bound to a network.
+func (c *Client) Network() *network.Network {
+	return c.config.Network
+}
+
+// VerifyNetwork checks whether the current host belongs to the network of the client
+func (c *Client) VerifyNetwork(ctx context.Context) error {
+	if c.config.Network == nil {
+		return nil
 	}
+
+	res, err := c.GetConstants(ctx)
+	if err != nil {
+		return err
+	}
+
+	if res.Constants == nil || res.Constants.Nethash != c.config.Network.Nethash {
+		return fmt.Errorf("host %s does not belong to network %s", c.Host().GetHostURL(), c.config.Network.Name)
+	}
+	return nil
 }
 
 // SetHost sets the Lisk node for the client requests
 func (c *Client) SetHost(host Host) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.host = host
 	c.restClient.SetHostURL(host.GetHostURL())
 }
 
-// ChangeRandomHost selects a new host from the pool for the client requests
+// Host returns the Lisk node that is currently used for the client requests
+func (c *Client) Host() Host {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.host
+}
+
+// ChangeRandomHost selects a new healthy host from the pool for the client requests
 func (c *Client) ChangeRandomHost() {
-	c.restClient.SetHostURL(c.config.GetRandomHost().GetHostURL())
+	c.failover(c.Host())
+}
+
+// Hosts returns all hosts of the pool
+func (c *Client) Hosts() []Host {
+	return c.pool.allHosts()
+}
+
+// HealthyHosts returns the hosts of the pool that are currently not ejected because of failed requests
+func (c *Client) HealthyHosts() []Host {
+	return c.pool.healthyHosts()
+}
+
+// CheckHosts requests the status of all hosts of the pool.
+// Hosts that are unreachable, still loading the blockchain or syncing are ejected from the pool,
+// all others are readmitted. If the current host is ejected, another one is selected.
+func (c *Client) CheckHosts(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, host := range c.pool.allHosts() {
+		wg.Add(1)
+		go func(host Host) {
+			defer wg.Done()
+
+			if c.isHostReady(ctx, host) {
+				c.pool.markReady(host)
+			} else {
+				c.pool.markFailed(host)
+			}
+		}(host)
+	}
+	wg.Wait()
+
+	current := c.Host()
+	for _, host := range c.pool.healthyHosts() {
+		if host == current {
+			return
+		}
+	}
+	c.failover(current)
+}
+
+// isHostReady returns whether the host is reachable and has loaded and synced the blockchain
+func (c *Client) isHostReady(ctx context.Context, host Host) bool {
+	req := c.restClient.R().SetContext(ctx)
+
+	req.SetResult(&NodeStatusReponse{})
+	req.SetError(Error{})
+
+	res, err := c.executeOnHost(req, resty.MethodGet, host, "api/node/status")
+	if err != nil {
+		return false
+	}
+
+	status := res.Result().(*NodeStatusReponse).NodeStatus
+	return status != nil && status.Loaded && !status.Syncing
+}
+
+// readyHost returns the current host. If RandomHost is set and the status of the host is due to be checked,
+// hosts that are not ready are ejected and another host of the pool is selected until a ready one is found.
+func (c *Client) readyHost(ctx context.Context) Host {
+	host := c.Host()
+	if !c.config.RandomHost {
+		return host
+	}
+
+	interval := c.config.getStatusCheckInterval()
+	for checks := len(c.pool.allHosts()); checks > 0 && c.pool.checkDue(host, interval); checks-- {
+		if c.isHostReady(ctx, host) {
+			c.pool.markReady(host)
+			break
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		c.pool.markFailed(host)
+		c.failover(host)
+		host = c.Host()
+	}
+
+	return host
+}
+
+// execute executes the request on the current host.
+// If the host fails and RandomHost is set, another host of the pool is selected for the following requests.
+// Before that, hosts are checked periodically and after a failover, so that requests are not sent to hosts
+// that are still loading the blockchain or syncing.
+// Idempotent GET requests are retried on that host with backoff up to MaxRetries times.
+// The returned error is either a request error or the API error returned by the node.
+func (c *Client) execute(req *resty.Request, method, path string) (*resty.Response, error) {
+	attempts := 1
+	if method == resty.MethodGet && c.config.RandomHost {
+		attempts += c.config.MaxRetries
+	}
+
+	var res *resty.Response
+	var err error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(c.config.getRetryBackoff(attempt)):
+			}
+		}
+
+		host := c.readyHost(req.Context())
+		res, err = req.Execute(method, host.GetHostURL()+"/"+path)
+
+		// Don't blame the host if the request was canceled
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+
+		if !isHostFailure(res, err) {
+			c.pool.markHealthy(host)
+			break
+		}
+
+		c.pool.markFailed(host)
+		c.failover(host)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, responseError(res)
+}
+
+// executeOnHost executes the request on the given host without retries and without changing the health of the host.
+// The returned error is either a request error or the API error returned by the node.
+func (c *Client) executeOnHost(req *resty.Request, method string, host Host, path string) (*resty.Response, error) {
+	res, err := req.Execute(method, host.GetHostURL()+"/"+path)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, responseError(res)
+}
+
+// failover selects another host from the pool if the failed host is still the current host
+func (c *Client) failover(failed Host) {
+	if !c.config.RandomHost {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.host != failed {
+		return
+	}
+
+	if host, ok := c.pool.next(failed); ok {
+		c.host = host
+		c.restClient.SetHostURL(host.GetHostURL())
+	}
+}
+
+// isHostFailure returns whether the request failed because of the host rather than because of the request itself
+func isHostFailure(res *resty.Response, err error) bool {
+	return err != nil || res.StatusCode() >= 500
 }
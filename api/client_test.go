@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testAPIRoutes maps request paths to handlers of a Lisk node stand-in.
+// A handler returns the data of the response or the HTTP status of an error response.
+type testAPIRoutes map[string]func(r *http.Request) (interface{}, int)
+
+// newTestAPIServer returns a Lisk node stand-in which serves the given routes
+func newTestAPIServer(t *testing.T, routes testAPIRoutes) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, ok := routes[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		data, status := route(r)
+
+		w.Header().Set("Content-Type", "application/json")
+		if status != 0 && status != http.StatusOK {
+			w.WriteHeader(status)
+			json.NewEncoder(w).Encode(map[string]interface{}{"message": http.StatusText(status)})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+}
+
+// testHost returns the host of the server
+func testHost(server *httptest.Server) Host {
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+	return Host{Hostname: serverURL.Hostname(), Port: port}
+}
+
+// testClient returns a client that uses the first server and fails over to the others
+func testClient(servers ...*httptest.Server) *Client {
+	var hosts []Host
+	for _, server := range servers {
+		hosts = append(hosts, testHost(server))
+	}
+
+	client := NewClientWithCustomConfig(&Config{
+		Host:            hosts[0],
+		RandomHost:      len(hosts) > 1,
+		RandomHostsPool: hosts,
+		Timeout:         time.Second,
+		MaxRetries:      len(hosts) - 1,
+		RetryBackoff:    time.Millisecond,
+		EjectionTime:    time.Minute,
+	})
+	client.SetHost(hosts[0])
+
+	return client
+}
+
+// testData returns the data of the route as response
+func testData(data interface{}) func(r *http.Request) (interface{}, int) {
+	return func(r *http.Request) (interface{}, int) {
+		return data, http.StatusOK
+	}
+}
+
+// testNodeStatus returns the status route of a node
+func testNodeStatus(loaded, syncing bool) func(r *http.Request) (interface{}, int) {
+	return testData(&NodeStatus{Loaded: loaded, Syncing: syncing, Height: 100})
+}
+
+func TestHostPool(t *testing.T) {
+	first, second, third := Host{Hostname: "1"}, Host{Hostname: "2"}, Host{Hostname: "3"}
+	pool := newHostPool([]Host{first, second, third}, time.Minute)
+
+	pool.markFailed(second)
+	pool.markFailed(third)
+	pool.markFailed(third)
+
+	if hosts := pool.healthyHosts(); len(hosts) != 1 || hosts[0] != first {
+		t.Errorf("hostPool.healthyHosts() returns %v; want %v", hosts, first)
+	}
+
+	if ejection := time.Until(pool.find(third).ejectedUntil); ejection < time.Minute || ejection > 2*time.Minute {
+		t.Errorf("hostPool.markFailed() ejects for %v; want doubled ejection time", ejection)
+	}
+
+	// All other hosts are ejected, so the one whose ejection ends first is used
+	if host, ok := pool.next(first); !ok || host != second {
+		t.Errorf("hostPool.next() returns %v,%v; want %v,true", host, ok, second)
+	}
+
+	pool.markHealthy(third)
+	if host, ok := pool.next(first); !ok || host != third {
+		t.Errorf("hostPool.next() returns %v,%v; want %v,true", host, ok, third)
+	}
+
+	pool.setHosts([]Host{second, first})
+	if hosts := pool.healthyHosts(); len(hosts) != 1 || hosts[0] != first {
+		t.Errorf("hostPool.setHosts() returns healthy hosts %v; want %v", hosts, first)
+	}
+
+	if host, ok := newHostPool([]Host{first}, time.Minute).next(first); ok {
+		t.Errorf("hostPool.next() returns %v,true for a single host; want false", host)
+	}
+}
+
+func TestHostPool_CheckDue(t *testing.T) {
+	host := Host{Hostname: "1"}
+	pool := newHostPool([]Host{host}, time.Minute)
+
+	if !pool.checkDue(host, time.Minute) {
+		t.Error("hostPool.checkDue() returns false for an unchecked host; want true")
+	}
+	if pool.checkDue(host, time.Minute) {
+		t.Error("hostPool.checkDue() returns true for a checked host; want false")
+	}
+
+	pool.markFailed(host)
+	if !pool.checkDue(host, time.Minute) {
+		t.Error("hostPool.checkDue() returns false for an ejected host; want true")
+	}
+}
+
+func TestClient_FailsOverOnServerError(t *testing.T) {
+	var failedRequests int32
+	failing := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": testNodeStatus(true, false),
+		"/api/node/constants": func(r *http.Request) (interface{}, int) {
+			atomic.AddInt32(&failedRequests, 1)
+			return nil, http.StatusInternalServerError
+		},
+	})
+	defer failing.Close()
+
+	healthy := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status":    testNodeStatus(true, false),
+		"/api/node/constants": testData(&Constants{Nethash: "abc"}),
+	})
+	defer healthy.Close()
+
+	client := testClient(failing, healthy)
+
+	res, err := client.GetConstants(context.Background())
+	if err != nil || res.Constants.Nethash != "abc" {
+		t.Fatalf("Client.GetConstants() returns %v,%v; want constants of the healthy host", res, err)
+	}
+
+	if failedRequests := atomic.LoadInt32(&failedRequests); failedRequests != 1 || client.Host() != testHost(healthy) {
+		t.Errorf("Client.GetConstants() sent %d requests to the failing host and uses %v; want 1 and %v",
+			failedRequests, client.Host(), testHost(healthy))
+	}
+
+	if hosts := client.HealthyHosts(); len(hosts) != 1 || hosts[0] != testHost(healthy) {
+		t.Errorf("Client.HealthyHosts() returns %v; want %v", hosts, testHost(healthy))
+	}
+}
+
+func TestClient_EjectsNodesThatAreNotReady(t *testing.T) {
+	tests := []struct {
+		loaded  bool
+		syncing bool
+	}{
+		{loaded: true, syncing: true},
+		{loaded: false, syncing: false},
+	}
+
+	for i, test := range tests {
+		notReady := newTestAPIServer(t, testAPIRoutes{
+			"/api/node/status": testNodeStatus(test.loaded, test.syncing),
+			"/api/node/constants": func(r *http.Request) (interface{}, int) {
+				t.Errorf("#%d: request sent to a host which is not ready", i)
+				return &Constants{}, http.StatusOK
+			},
+		})
+
+		ready := newTestAPIServer(t, testAPIRoutes{
+			"/api/node/status":    testNodeStatus(true, false),
+			"/api/node/constants": testData(&Constants{Nethash: "abc"}),
+		})
+
+		client := testClient(notReady, ready)
+
+		if res, err := client.GetConstants(context.Background()); err != nil || res.Constants.Nethash != "abc" {
+			t.Errorf("#%d: Client.GetConstants() returns %v,%v; want constants of the ready host", i, res, err)
+		}
+
+		if hosts := client.HealthyHosts(); len(hosts) != 1 || hosts[0] != testHost(ready) {
+			t.Errorf("#%d: Client.HealthyHosts() returns %v; want %v", i, hosts, testHost(ready))
+		}
+
+		notReady.Close()
+		ready.Close()
+	}
+}
+
+func TestClient_RechecksStatusPeriodically(t *testing.T) {
+	var mu sync.Mutex
+	var statusChecks int
+	syncing := false
+
+	first := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": func(r *http.Request) (interface{}, int) {
+			mu.Lock()
+			defer mu.Unlock()
+			statusChecks++
+			return &NodeStatus{Loaded: true, Syncing: syncing}, http.StatusOK
+		},
+		"/api/node/constants": testData(&Constants{Nethash: "first"}),
+	})
+	defer first.Close()
+
+	second := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status":    testNodeStatus(true, false),
+		"/api/node/constants": testData(&Constants{Nethash: "second"}),
+	})
+	defer second.Close()
+
+	client := testClient(first, second)
+	client.config.StatusCheckInterval = 50 * time.Millisecond
+
+	for i := 0; i < 3; i++ {
+		if res, err := client.GetConstants(context.Background()); err != nil || res.Constants.Nethash != "first" {
+			t.Fatalf("Client.GetConstants() returns %v,%v; want constants of the first host", res, err)
+		}
+	}
+	mu.Lock()
+	if statusChecks != 1 {
+		t.Errorf("Client.GetConstants() checked the status %d times within the interval; want 1", statusChecks)
+	}
+	syncing = true
+	mu.Unlock()
+	time.Sleep(60 * time.Millisecond)
+
+	if res, err := client.GetConstants(context.Background()); err != nil || res.Constants.Nethash != "second" {
+		t.Errorf("Client.GetConstants() returns %v,%v; want constants of the second host", res, err)
+	}
+}
+
+func TestClient_DoesNotCheckSingleHost(t *testing.T) {
+	server := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/constants": testData(&Constants{Nethash: "abc"}),
+	})
+	defer server.Close()
+
+	if res, err := testClient(server).GetConstants(context.Background()); err != nil || res.Constants.Nethash != "abc" {
+		t.Errorf("Client.GetConstants() returns %v,%v; want constants", res, err)
+	}
+}
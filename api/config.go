@@ -5,11 +5,16 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/liskascend/lisk-go/network"
 )
 
 type (
 	// Config is the config for the Lisk API client
 	Config struct {
+		// Network is the network the client connects to. Transactions of other networks are rejected.
+		// It can be nil to connect to any network.
+		Network *network.Network
 		// Host to use for API calls - can be nil when RandomHost is true.
 		Host Host
 		// RandomHost specifies whether a random Host from RandomHostsPool should be used.
@@ -18,6 +23,25 @@ type (
 		RandomHostsPool []Host
 		// Debug specifies whether debug logging for the API client should be activated.
 		Debug bool
+		// Timeout is the timeout of a single request. No timeout is used if it's 0.
+		Timeout time.Duration
+		// MaxRetries is the number of times a failed GET request is retried on another host of the RandomHostsPool.
+		// Requests are only retried when RandomHost is true.
+		MaxRetries int
+		// RetryBackoff is the time to wait before the first retry. It doubles with every further retry.
+		// DefaultRetryBackoff is used if it's 0.
+		RetryBackoff time.Duration
+		// EjectionTime is the time a failed host is not used for requests. It doubles with every consecutive failure.
+		// DefaultEjectionTime is used if it's 0.
+		EjectionTime time.Duration
+		// StatusCheckInterval is the interval in which the status of the current host is checked before requests
+		// when RandomHost is true. A host is also checked before its first request after a failover.
+		// Hosts that are still loading the blockchain or syncing are ejected from the pool.
+		// DefaultStatusCheckInterval is used if it's 0.
+		StatusCheckInterval time.Duration
+		// Discovery enables the discovery of hosts from the peers of the network if set.
+		// It is only used when RandomHost is true.
+		Discovery *DiscoveryConfig
 	}
 	// Host is a Lisk Node
 	Host struct {
@@ -30,14 +54,41 @@ type (
 	}
 )
 
+const (
+	// DefaultRetryBackoff is the default time to wait before the first retry of a failed request
+	DefaultRetryBackoff = 500 * time.Millisecond
+	// DefaultEjectionTime is the default time a failed host is not used for requests
+	DefaultEjectionTime = 30 * time.Second
+	// DefaultStatusCheckInterval is the default interval in which the status of the current host is checked
+	DefaultStatusCheckInterval = time.Minute
+
+	// maxRetryBackoff is the maximum time to wait between retries
+	maxRetryBackoff = 10 * time.Second
+	// maxEjectionFactor limits the ejection time of hosts with many consecutive failures
+	maxEjectionFactor = 16
+)
+
 var (
 	// DefaultConfig is the default config for the Lisk API client
-	DefaultConfig = &Config{
-		RandomHostsPool: []Host{{"betanet.lisk.io", 5000, false}},
+	DefaultConfig = NewConfigForNetwork(network.Betanet)
+)
+
+// NewConfigForNetwork returns a config that connects to a random seed node of the network
+func NewConfigForNetwork(net *network.Network) *Config {
+	var hosts []Host
+	for _, node := range net.SeedNodes {
+		hosts = append(hosts, Host(node))
+	}
+
+	return &Config{
+		Network:         net,
+		RandomHostsPool: hosts,
 		RandomHost:      true,
 		Debug:           false,
+		Timeout:         10 * time.Second,
+		MaxRetries:      3,
 	}
-)
+}
 
 // GetHostURL composes a URL from the host details
 func (h Host) GetHostURL() string {
@@ -59,3 +110,33 @@ func (c *Config) GetRandomHost() Host {
 	rand.Seed(time.Now().Unix())
 	return c.RandomHostsPool[rand.Intn(len(c.RandomHostsPool))]
 }
+
+// getRetryBackoff returns the time to wait before the given retry
+func (c *Config) getRetryBackoff(retry int) time.Duration {
+	backoff := c.RetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+
+	backoff = backoff << uint(retry-1)
+	if backoff > maxRetryBackoff || backoff <= 0 {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// getEjectionTime returns the time a failed host is not used for requests
+func (c *Config) getEjectionTime() time.Duration {
+	if c.EjectionTime <= 0 {
+		return DefaultEjectionTime
+	}
+	return c.EjectionTime
+}
+
+// getStatusCheckInterval returns the interval in which the status of the current host is checked
+func (c *Config) getStatusCheckInterval() time.Duration {
+	if c.StatusCheckInterval <= 0 {
+		return DefaultStatusCheckInterval
+	}
+	return c.StatusCheckInterval
+}
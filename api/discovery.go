@@ -0,0 +1,354 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty"
+)
+
+type (
+	// DiscoveryConfig is the config for the discovery of hosts from the peers of the network
+	DiscoveryConfig struct {
+		// SeedHosts are the hosts whose peers are discovered. RandomHostsPool is used if it's empty.
+		SeedHosts []Host
+		// Interval is the interval in which the hosts are rediscovered. DefaultDiscoveryInterval is used if it's 0.
+		Interval time.Duration
+		// MinVersion is the minimum Lisk Core version of a peer, e.g. "1.0.0". Any version is accepted if it's empty.
+		MinVersion string
+		// MaxHeightLag is the maximum number of blocks a peer may be behind the median height of all peers.
+		// DefaultMaxHeightLag is used if it's 0.
+		MaxHeightLag int64
+		// IgnoreBroadhash disables the filtering of peers whose broadhash differs from the one of most peers.
+		IgnoreBroadhash bool
+		// MaxHosts is the maximum number of hosts in the pool. DefaultMaxDiscoveredHosts is used if it's 0.
+		MaxHosts int
+		// Secure specifies whether https should be used for the discovered peers.
+		Secure bool
+	}
+)
+
+const (
+	// DefaultDiscoveryInterval is the default interval in which hosts are rediscovered
+	DefaultDiscoveryInterval = 5 * time.Minute
+	// DefaultMaxHeightLag is the default number of blocks a peer may be behind the other peers
+	DefaultMaxHeightLag = 10
+	// DefaultMaxDiscoveredHosts is the default maximum number of hosts in the pool
+	DefaultMaxDiscoveredHosts = 20
+
+	// peerStateConnected is the state of a peer the node is connected to
+	peerStateConnected = 2
+	// maxConcurrentProbes is the maximum number of peers that are probed at the same time
+	maxConcurrentProbes = 10
+)
+
+// StartDiscovery discovers hosts from the peers of the network and keeps the pool of the client up to date
+// in the background until the context is canceled.
+// Failed discoveries leave the pool unchanged.
+func (c *Client) StartDiscovery(ctx context.Context) error {
+	if c.config.Discovery == nil {
+		return errors.New("discovery is not configured")
+	}
+
+	go func() {
+		ticker := time.NewTicker(c.config.Discovery.getInterval())
+		defer ticker.Stop()
+
+		for {
+			c.Discover(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Discover requests the connected peers of the seed hosts and the hosts of the pool.
+// The seed hosts and the peers that match the criteria of the DiscoveryConfig and serve a public API
+// replace the hosts of the pool.
+// If the current host is not part of the new pool, another one is selected.
+func (c *Client) Discover(ctx context.Context) error {
+	discovery := c.config.Discovery
+	if discovery == nil {
+		return errors.New("discovery is not configured")
+	}
+
+	seeds := discovery.SeedHosts
+	if len(seeds) == 0 {
+		seeds = c.config.RandomHostsPool
+	}
+	sources := uniqueHosts(append(append([]Host{}, seeds...), c.pool.allHosts()...))
+	peers := filterPeers(c.getConnectedPeers(ctx, sources), discovery)
+
+	candidates := append([]Host{}, seeds...)
+	for _, peer := range peers {
+		candidates = append(candidates, Host{Hostname: peer.IP, Port: peer.HTTPPort, Secure: discovery.Secure})
+	}
+
+	hosts := c.probeHosts(ctx, uniqueHosts(candidates), discovery.getMaxHosts())
+	if len(hosts) == 0 {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.New("no reachable hosts discovered")
+	}
+
+	c.pool.setHosts(hosts)
+
+	current := c.Host()
+	for _, host := range hosts {
+		if host == current {
+			return nil
+		}
+	}
+	c.failover(current)
+
+	return nil
+}
+
+// getConnectedPeers returns the connected peers of the given hosts
+func (c *Client) getConnectedPeers(ctx context.Context, hosts []Host) []*Peer {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	var peers []*Peer
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host Host) {
+			defer wg.Done()
+
+			req := c.restClient.R().SetContext(ctx)
+
+			req.SetQueryParam("state", strconv.Itoa(peerStateConnected))
+			req.SetQueryParam("limit", "100")
+
+			req.SetResult(&PeerResponse{})
+			req.SetError(Error{})
+
+			res, err := c.executeOnHost(req, resty.MethodGet, host, "api/peers")
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			peers = append(peers, res.Result().(*PeerResponse).Peers...)
+			mu.Unlock()
+		}(host)
+	}
+	wg.Wait()
+
+	return peers
+}
+
+// probeHosts returns up to max hosts that are reachable and have loaded and synced the blockchain.
+// The order of the given hosts is preserved.
+func (c *Client) probeHosts(ctx context.Context, hosts []Host, max int) []Host {
+	ready := make([]bool, len(hosts))
+	slots := make(chan struct{}, maxConcurrentProbes)
+
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func(i int, host Host) {
+			defer wg.Done()
+
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			ready[i] = c.isHostReady(ctx, host)
+		}(i, host)
+	}
+	wg.Wait()
+
+	var result []Host
+	for i, host := range hosts {
+		if ready[i] && len(result) < max {
+			result = append(result, host)
+		}
+	}
+	return result
+}
+
+// filterPeers returns the unique peers that match the version, height and broadhash criteria of the config
+func filterPeers(peers []*Peer, discovery *DiscoveryConfig) []*Peer {
+	seen := make(map[string]bool)
+
+	var candidates []*Peer
+	for _, peer := range peers {
+		key := peer.IP + ":" + strconv.Itoa(peer.HTTPPort)
+		if seen[key] || peer.IP == "" || peer.HTTPPort == 0 || peer.State != peerStateConnected {
+			continue
+		}
+		seen[key] = true
+
+		if discovery.MinVersion != "" && compareVersions(peer.Version, discovery.MinVersion) < 0 {
+			continue
+		}
+		candidates = append(candidates, peer)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Use the median height as reference so single peers can't push the others out by reporting a wrong height
+	heights := make([]int64, 0, len(candidates))
+	broadhashes := make(map[string]int)
+	for _, peer := range candidates {
+		heights = append(heights, peer.Height)
+		broadhashes[peer.Broadhash]++
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	minHeight := heights[len(heights)/2] - discovery.getMaxHeightLag()
+
+	var broadhash string
+	for hash, count := range broadhashes {
+		if count > broadhashes[broadhash] || (count == broadhashes[broadhash] && hash < broadhash) {
+			broadhash = hash
+		}
+	}
+
+	var result []*Peer
+	for _, peer := range candidates {
+		if peer.Height < minHeight {
+			continue
+		}
+		if !discovery.IgnoreBroadhash && peer.Broadhash != broadhash {
+			continue
+		}
+		result = append(result, peer)
+	}
+	return result
+}
+
+// compareVersions compares two Lisk Core versions like "1.0.0-beta.9".
+// It returns -1 if a is lower than b, 1 if it's higher and 0 if they are equal.
+// Pre-releases are lower than the release of the same version.
+func compareVersions(a, b string) int {
+	aVersion, aPreRelease := splitVersion(a)
+	bVersion, bPreRelease := splitVersion(b)
+
+	for i := range aVersion {
+		if aVersion[i] != bVersion[i] {
+			if aVersion[i] < bVersion[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case aPreRelease == bPreRelease:
+		return 0
+	case aPreRelease == "":
+		return 1
+	case bPreRelease == "":
+		return -1
+	}
+
+	return comparePreReleases(strings.Split(aPreRelease, "."), strings.Split(bPreRelease, "."))
+}
+
+// comparePreReleases compares the dot separated identifiers of two pre-releases like semver does.
+// Numeric identifiers are compared numerically and are lower than alphanumeric ones.
+func comparePreReleases(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		aNumber, aErr := strconv.Atoi(a[i])
+		bNumber, bErr := strconv.Atoi(b[i])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNumber != bNumber {
+				if aNumber < bNumber {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case a[i] != b[i]:
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// splitVersion splits a version into its numeric major, minor and patch parts and the pre-release suffix
+func splitVersion(version string) ([3]int, string) {
+	var numbers [3]int
+
+	preRelease := ""
+	if i := strings.Index(version, "-"); i >= 0 {
+		version, preRelease = version[:i], version[i+1:]
+	}
+
+	for i, part := range strings.SplitN(version, ".", 3) {
+		numbers[i], _ = strconv.Atoi(part)
+	}
+	return numbers, preRelease
+}
+
+// uniqueHosts removes duplicates from the hosts while preserving their order
+func uniqueHosts(hosts []Host) []Host {
+	var result []Host
+	for _, host := range hosts {
+		duplicate := false
+		for _, existing := range result {
+			if existing == host {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			result = append(result, host)
+		}
+	}
+	return result
+}
+
+// getInterval returns the discovery interval
+func (d *DiscoveryConfig) getInterval() time.Duration {
+	if d.Interval <= 0 {
+		return DefaultDiscoveryInterval
+	}
+	return d.Interval
+}
+
+// getMaxHeightLag returns the maximum number of blocks a peer may be behind
+func (d *DiscoveryConfig) getMaxHeightLag() int64 {
+	if d.MaxHeightLag <= 0 {
+		return DefaultMaxHeightLag
+	}
+	return d.MaxHeightLag
+}
+
+// getMaxHosts returns the maximum number of hosts in the pool
+func (d *DiscoveryConfig) getMaxHosts() int {
+	if d.MaxHosts <= 0 {
+		return DefaultMaxDiscoveredHosts
+	}
+	return d.MaxHosts
+}
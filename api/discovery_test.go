@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.1", "1.0.0", 1},
+		{"1.0.0", "1.1.0", -1},
+		{"2.0.0", "1.9.9", 1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0", "1.0.0", 0},
+		{"1.0.0-beta.9", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0-beta.9", "1.0.0-beta.10", -1},
+		{"1.0.0-beta.9", "1.0.0-rc.1", -1},
+		{"1.0.0-beta", "1.0.0-beta.1", -1},
+		{"1.0.0-1", "1.0.0-alpha", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+		{"1.1.0-alpha.0", "1.0.0", 1},
+	}
+
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			t.Errorf("compareVersions(%q, %q)=%d; want %d", test.a, test.b, got, test.want)
+		}
+		if got := compareVersions(test.b, test.a); got != -test.want {
+			t.Errorf("compareVersions(%q, %q)=%d; want %d", test.b, test.a, got, -test.want)
+		}
+	}
+}
+
+// testPeer returns a connected peer at the given height with the given broadhash
+func testPeer(ip string, version string, height int64, broadhash string) *Peer {
+	return &Peer{IP: ip, HTTPPort: 7000, Version: version, State: peerStateConnected, Height: height,
+		Broadhash: broadhash}
+}
+
+func TestFilterPeers(t *testing.T) {
+	tests := []struct {
+		name      string
+		peers     []*Peer
+		discovery *DiscoveryConfig
+		want      []string
+	}{
+		{
+			name: "version",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("2", "1.0.0-beta.9", 100, "a"),
+				testPeer("3", "1.1.0", 100, "a"),
+			},
+			discovery: &DiscoveryConfig{MinVersion: "1.0.0"},
+			want:      []string{"1", "3"},
+		},
+		{
+			name: "height lag behind the median",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("2", "1.0.0", 95, "a"),
+				testPeer("3", "1.0.0", 94, "a"),
+				testPeer("4", "1.0.0", 1000000, "a"),
+			},
+			discovery: &DiscoveryConfig{MaxHeightLag: 5},
+			want:      []string{"1", "2", "4"},
+		},
+		{
+			name: "default height lag",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("2", "1.0.0", 100, "a"),
+				testPeer("3", "1.0.0", 90, "a"),
+				testPeer("4", "1.0.0", 89, "a"),
+			},
+			discovery: &DiscoveryConfig{},
+			want:      []string{"1", "2", "3"},
+		},
+		{
+			name: "broadhash majority",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("2", "1.0.0", 100, "b"),
+				testPeer("3", "1.0.0", 100, "b"),
+			},
+			discovery: &DiscoveryConfig{},
+			want:      []string{"2", "3"},
+		},
+		{
+			name: "broadhash tie",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "b"),
+				testPeer("2", "1.0.0", 100, "a"),
+			},
+			discovery: &DiscoveryConfig{},
+			want:      []string{"2"},
+		},
+		{
+			name: "ignored broadhash",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("2", "1.0.0", 100, "b"),
+			},
+			discovery: &DiscoveryConfig{IgnoreBroadhash: true},
+			want:      []string{"1", "2"},
+		},
+		{
+			name: "duplicates, disconnected peers and peers without API",
+			peers: []*Peer{
+				testPeer("1", "1.0.0", 100, "a"),
+				testPeer("1", "1.0.0", 100, "a"),
+				{IP: "2", HTTPPort: 7000, State: 1, Height: 100, Broadhash: "a"},
+				{IP: "3", State: peerStateConnected, Height: 100, Broadhash: "a"},
+			},
+			discovery: &DiscoveryConfig{},
+			want:      []string{"1"},
+		},
+		{
+			name:      "no peers",
+			discovery: &DiscoveryConfig{},
+		},
+	}
+
+	for _, test := range tests {
+		result := filterPeers(test.peers, test.discovery)
+
+		var ips []string
+		for _, peer := range result {
+			ips = append(ips, peer.IP)
+		}
+
+		if len(ips) != len(test.want) {
+			t.Errorf("%s: filterPeers() returns %v; want %v", test.name, ips, test.want)
+			continue
+		}
+		for i := range ips {
+			if ips[i] != test.want[i] {
+				t.Errorf("%s: filterPeers() returns %v; want %v", test.name, ips, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestClient_Discover(t *testing.T) {
+	synced := newTestAPIServer(t, testAPIRoutes{"/api/node/status": testNodeStatus(true, false)})
+	defer synced.Close()
+
+	syncing := newTestAPIServer(t, testAPIRoutes{"/api/node/status": testNodeStatus(true, true)})
+	defer syncing.Close()
+
+	outdated := newTestAPIServer(t, testAPIRoutes{"/api/node/status": testNodeStatus(true, false)})
+	defer outdated.Close()
+
+	peer := func(server *httptest.Server, version string) *Peer {
+		host := testHost(server)
+		return &Peer{IP: host.Hostname, HTTPPort: host.Port, Version: version, State: peerStateConnected,
+			Height: 100, Broadhash: "a"}
+	}
+
+	var seed *httptest.Server
+	seed = newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": testNodeStatus(true, false),
+		"/api/peers": func(r *http.Request) (interface{}, int) {
+			return []*Peer{peer(synced, "1.0.0"), peer(syncing, "1.0.0"), peer(outdated, "0.9.16"),
+				peer(seed, "1.0.0")}, http.StatusOK
+		},
+	})
+	defer seed.Close()
+
+	client := testClient(seed)
+	client.config.Discovery = &DiscoveryConfig{MinVersion: "1.0.0"}
+
+	if err := client.Discover(context.Background()); err != nil {
+		t.Fatalf("Client.Discover() returns error: %v", err)
+	}
+
+	hosts := client.Hosts()
+	if len(hosts) != 2 || hosts[0] != testHost(seed) || hosts[1] != testHost(synced) {
+		t.Errorf("Client.Discover() sets hosts %v; want %v", hosts, []Host{testHost(seed), testHost(synced)})
+	}
+}
@@ -1,6 +1,12 @@
 package api
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty"
+)
 
 type (
 	// Error represents an API error
@@ -30,6 +36,22 @@ type (
 			} `json:"errors"`
 		} `json:"errors"`
 	}
+
+	// TransactionError is the error of a single transaction of an operation on multiple transactions
+	TransactionError struct {
+		// TransactionID is the ID of the affected transaction
+		TransactionID string
+		// Err is the error of the transaction
+		Err error
+	}
+
+	// TransactionErrors are the errors of all transactions that failed in an operation on multiple transactions
+	TransactionErrors []*TransactionError
+)
+
+var (
+	// errNoNodeStatus is returned when the node status response contains no status
+	errNoNodeStatus = errors.New("node returned no status")
 )
 
 // Error prints API errors in a human readable format
@@ -51,3 +73,33 @@ func (e *Error) Error() string {
 	}
 	return b.String()
 }
+
+// Error returns the transaction ID and the error
+func (e *TransactionError) Error() string {
+	return "transaction " + e.TransactionID + ": " + e.Err.Error()
+}
+
+// Error returns all errors separated by semicolons
+func (e TransactionErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// add appends the error of the transaction
+func (e *TransactionErrors) add(transactionID string, err error) {
+	*e = append(*e, &TransactionError{TransactionID: transactionID, Err: err})
+}
+
+// responseError returns the API error of the response or nil if the request was successful
+func responseError(res *resty.Response) error {
+	if !res.IsError() {
+		return nil
+	}
+	if apiError, ok := res.Error().(*Error); ok && apiError.Message != "" {
+		return apiError
+	}
+	return fmt.Errorf("request failed with status %s", res.Status())
+}
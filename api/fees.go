@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/liskascend/lisk-go/network"
+)
+
+type (
+	// FeeProvider provides the fees of the network from the constants of the nodes the client is connected to.
+	// The fees are fetched explicitly using Refresh or in the background using Start and cached, so that computing
+	// the fee of a transaction never waits for a request.
+	// It can be attached to a network using network.Network.WithFeeProvider.
+	FeeProvider struct {
+		client *Client
+		ttl    time.Duration
+
+		mu   sync.Mutex
+		fees *network.FeeSchedule
+	}
+)
+
+const (
+	// DefaultFeeTTL is the default interval in which the fees are refreshed in the background
+	DefaultFeeTTL = 10 * time.Minute
+
+	// feeRetryInterval is the maximum interval between attempts to refresh the fees after a failure
+	feeRetryInterval = 30 * time.Second
+)
+
+var (
+	// errFeesUnavailable is returned when the fees have not been fetched yet
+	errFeesUnavailable = errors.New("fees have not been fetched yet")
+)
+
+// NewFeeProvider returns a fee provider that fetches the fees using the client.
+// If it's started, the fees are refreshed every ttl. DefaultFeeTTL is used if ttl is 0.
+func NewFeeProvider(client *Client, ttl time.Duration) *FeeProvider {
+	if ttl <= 0 {
+		ttl = DefaultFeeTTL
+	}
+
+	return &FeeProvider{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// FeeSchedule returns the cached fees without sending any request.
+// It returns an error if the fees have not been fetched yet, so the network falls back to its built-in fees.
+func (p *FeeProvider) FeeSchedule() (*network.FeeSchedule, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.fees == nil {
+		return nil, errFeesUnavailable
+	}
+	return p.fees, nil
+}
+
+// Start refreshes the fees every TTL in the background until the context is canceled.
+// Failed refreshes are retried earlier and keep the previously fetched fees.
+// The fees should be fetched using Refresh before, as the first refresh happens after the TTL.
+func (p *FeeProvider) Start(ctx context.Context) {
+	go func() {
+		interval := p.ttl
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			interval = p.ttl
+			if err := p.Refresh(ctx); err != nil && interval > feeRetryInterval {
+				interval = feeRetryInterval
+			}
+		}
+	}()
+}
+
+// Refresh fetches the fees from the constants of the node
+func (p *FeeProvider) Refresh(ctx context.Context) error {
+	res, err := p.client.GetConstants(ctx)
+	if err != nil {
+		return err
+	}
+
+	constants := res.Constants
+	if constants == nil || constants.Fees == nil {
+		return errors.New("node returned no fees")
+	}
+
+	if net := p.client.Network(); net != nil && constants.Nethash != net.Nethash {
+		return fmt.Errorf("node returned the fees of another network than %s", net.Name)
+	}
+
+	fees := &network.FeeSchedule{
+		Send:             uint64(constants.Fees.Send),
+		Data:             uint64(constants.Fees.Data),
+		SecondSignature:  uint64(constants.Fees.SecondSignature),
+		Delegate:         uint64(constants.Fees.Delegate),
+		Vote:             uint64(constants.Fees.Vote),
+		Multisignature:   uint64(constants.Fees.Multisignature),
+		DappRegistration: uint64(constants.Fees.DappRegistration),
+		DappDeposit:      uint64(constants.Fees.DappDeposit),
+		DappWithdrawal:   uint64(constants.Fees.DappWithdrawal),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fees = fees
+
+	return nil
+}
+
+// NetworkWithNodeFees returns a copy of the network of the client whose fees are fetched from the nodes
+// the client is connected to. The fees are fetched before it returns and refreshed every ttl in the background
+// until the context is canceled.
+// It returns an error if the client is not bound to a network or the fees can't be fetched.
+func (c *Client) NetworkWithNodeFees(ctx context.Context, ttl time.Duration) (*network.Network, error) {
+	if c.config.Network == nil {
+		return nil, errors.New("client is not bound to a network")
+	}
+
+	provider := NewFeeProvider(c, ttl)
+	if err := provider.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	provider.Start(ctx)
+
+	return c.config.Network.WithFeeProvider(provider), nil
+}
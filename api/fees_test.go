@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+// newTestFeeServer returns a node stand-in that reports the given send fee and counts the constants requests
+func newTestFeeServer(t *testing.T, send *int64, requests *int32) *httptest.Server {
+	return newTestAPIServer(t, testAPIRoutes{
+		"/api/node/constants": func(r *http.Request) (interface{}, int) {
+			atomic.AddInt32(requests, 1)
+			fee := atomic.LoadInt64(send)
+			if fee == 0 {
+				return nil, http.StatusServiceUnavailable
+			}
+			return &Constants{Nethash: network.Testnet.Nethash, Fees: &Fees{Send: LiskAmount(fee)}}, http.StatusOK
+		},
+	})
+}
+
+func TestFeeProvider(t *testing.T) {
+	var send int64
+	var requests int32
+
+	server := newTestFeeServer(t, &send, &requests)
+	defer server.Close()
+
+	client := testClient(server)
+	client.config.Network = network.Testnet
+
+	provider := NewFeeProvider(client, time.Hour)
+	net := network.Testnet.WithFeeProvider(provider)
+
+	if fees, err := provider.FeeSchedule(); err == nil {
+		t.Errorf("FeeProvider.FeeSchedule() returns %v,nil before the fees were fetched; want error", fees)
+	}
+	if fees := net.GetFees(); fees != network.Testnet.Fees {
+		t.Errorf("Network.GetFees() returns %v before the fees were fetched; want the built-in fees", fees)
+	}
+
+	if err := provider.Refresh(context.Background()); err == nil {
+		t.Error("FeeProvider.Refresh() returns no error for a failing node; want error")
+	}
+
+	atomic.StoreInt64(&send, 1)
+	if err := provider.Refresh(context.Background()); err != nil {
+		t.Fatalf("FeeProvider.Refresh() returns error: %v", err)
+	}
+
+	atomic.StoreInt64(&send, 0)
+	if err := provider.Refresh(context.Background()); err == nil {
+		t.Error("FeeProvider.Refresh() returns no error for a failing node; want error")
+	}
+
+	// Computing fees must not send requests
+	before := atomic.LoadInt32(&requests)
+	transaction := &transactions.Transaction{Type: transactions.TransactionTypeNormal, Network: net}
+	for i := 0; i < 3; i++ {
+		if fee, err := transaction.Fee(); fee != 1 || err != nil {
+			t.Errorf("Transaction.Fee() returns %v,%v; want the fetched fee 1", fee, err)
+		}
+	}
+	if after := atomic.LoadInt32(&requests); after != before {
+		t.Errorf("Transaction.Fee() sent %d requests; want none", after-before)
+	}
+}
+
+func TestClient_NetworkWithNodeFees(t *testing.T) {
+	send := int64(1)
+	var requests int32
+
+	server := newTestFeeServer(t, &send, &requests)
+	defer server.Close()
+
+	client := testClient(server)
+
+	if net, err := client.NetworkWithNodeFees(context.Background(), time.Hour); err == nil {
+		t.Errorf("Client.NetworkWithNodeFees() returns %v,nil without network; want error", net)
+	}
+
+	client.config.Network = network.Testnet
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	net, err := client.NetworkWithNodeFees(ctx, 20*time.Millisecond)
+	if err != nil || net.GetFees().Send != 1 {
+		t.Fatalf("Client.NetworkWithNodeFees() returns %v,%v; want network with the fees of the node", net, err)
+	}
+
+	atomic.StoreInt64(&send, 2)
+	deadline := time.Now().Add(time.Second)
+	for net.GetFees().Send != 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("Client.NetworkWithNodeFees() does not refresh the fees in the background")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
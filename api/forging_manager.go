@@ -0,0 +1,346 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// ForgingEvent is a change of the forging status or a problem detected by a ForgingManager
+	ForgingEvent struct {
+		// Host is the node the event belongs to
+		Host Host
+		// Forging is the forging status of the node after the event
+		Forging bool
+		// Reason describes the event
+		Reason string
+		// Err is set if the forging status of the node could not be changed
+		Err error
+	}
+
+	// ForgingManager keeps forging enabled on exactly one healthy and synced node of a set of nodes.
+	// Forging is only enabled on a node after it has been disabled on all other nodes. If the forging node becomes
+	// unreachable, forging is only moved to another node after the delegate missed a slot, because the node might
+	// still be forging.
+	ForgingManager struct {
+		nodes         []*forgingNode
+		publicKey     string
+		decryptionKey string
+
+		// CheckInterval is the interval in which the nodes are checked
+		CheckInterval time.Duration
+		// MaxHeightLag is the maximum number of blocks a healthy node may be behind the network height
+		MaxHeightLag int
+
+		// nextSlot is the next slot of the delegate or 0 if it's unknown
+		nextSlot int
+		// missedSlot is set if the delegate missed a slot since forging was last seen on a node
+		missedSlot bool
+	}
+
+	// forgingNode is a node managed by a ForgingManager
+	forgingNode struct {
+		host   Host
+		client *Client
+
+		// reachable is set if the node responded to the last check
+		reachable bool
+		// healthy is set if the node is synced and has the delegate configured
+		healthy bool
+		// forging is the last known forging status of the node
+		forging bool
+		// known is set once the forging status of the node is known
+		known bool
+		// height of the node
+		height int
+	}
+)
+
+const (
+	// DefaultForgingCheckInterval is the default interval in which a ForgingManager checks the nodes
+	DefaultForgingCheckInterval = 2 * time.Second
+	// DefaultForgingMaxHeightLag is the default number of blocks a healthy node may be behind the network height
+	DefaultForgingMaxHeightLag = 2
+
+	// slotInterval is the duration of a forging slot in seconds
+	slotInterval = 10
+)
+
+var (
+	// errForgingNotChanged is returned if a node does not change its forging status
+	errForgingNotChanged = errors.New("node did not change the forging status")
+)
+
+// NewForgingManager returns a manager for the delegate with the given public key that controls forging on the
+// nodes. The decryption key is the password of the encrypted passphrase in the config of the nodes.
+// A client bound to the single node is created for each node using the settings of the config.
+// The nodes must whitelist the host of the manager for forging requests.
+func NewForgingManager(config *Config, nodes []Host, publicKey, decryptionKey string) *ForgingManager {
+	manager := &ForgingManager{
+		publicKey:     publicKey,
+		decryptionKey: decryptionKey,
+		CheckInterval: DefaultForgingCheckInterval,
+		MaxHeightLag:  DefaultForgingMaxHeightLag,
+	}
+
+	for _, host := range nodes {
+		nodeConfig := *config
+		nodeConfig.Host = host
+		nodeConfig.RandomHost = false
+		nodeConfig.RandomHostsPool = nil
+		nodeConfig.Discovery = nil
+
+		manager.nodes = append(manager.nodes, &forgingNode{
+			host:   host,
+			client: NewClientWithCustomConfig(&nodeConfig),
+		})
+	}
+
+	return manager
+}
+
+// Run checks the nodes until the context is canceled and sends the changes of the forging status on the
+// returned channel. The channel has to be read, otherwise the manager blocks.
+func (m *ForgingManager) Run(ctx context.Context) <-chan *ForgingEvent {
+	events := make(chan *ForgingEvent)
+
+	go func() {
+		defer close(events)
+
+		interval := m.CheckInterval
+		if interval <= 0 {
+			interval = DefaultForgingCheckInterval
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := m.check(ctx, events); err != nil && ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// check updates the state of all nodes and moves forging to a healthy node if required
+func (m *ForgingManager) check(ctx context.Context, events chan<- *ForgingEvent) error {
+	var reference *forgingNode
+	for _, node := range m.nodes {
+		m.updateNode(ctx, node)
+
+		if node.healthy && (reference == nil || node.height > reference.height) {
+			reference = node
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if reference != nil {
+		if err := m.detectMissedSlot(ctx, reference.client, events); err != nil {
+			return err
+		}
+	}
+
+	var forging []*forgingNode
+	for _, node := range m.nodes {
+		if node.reachable && node.forging {
+			forging = append(forging, node)
+		}
+	}
+
+	switch {
+	case len(forging) > 1:
+		// Keep forging on the healthiest node only
+		active := forging[0]
+		for _, node := range forging[1:] {
+			if node.healthy && (!active.healthy || node.height > active.height) {
+				active = node
+			}
+		}
+
+		for _, node := range forging {
+			if node != active {
+				if err := m.setForging(ctx, node, false, "forging on multiple nodes", events); err != nil {
+					return err
+				}
+			}
+		}
+		m.missedSlot = false
+		return nil
+	case len(forging) == 1:
+		m.missedSlot = false
+
+		active := forging[0]
+		if active.healthy || reference == nil {
+			return nil
+		}
+
+		if err := m.setForging(ctx, active, false, "node is not synced", events); err != nil {
+			return err
+		}
+	}
+
+	if reference == nil {
+		return nil
+	}
+
+	// An unreachable node might still be forging
+	for _, node := range m.nodes {
+		if !node.reachable && (node.forging || !node.known) && !m.missedSlot {
+			return nil
+		}
+	}
+
+	if err := m.setForging(ctx, reference, true, "no node is forging", events); err != nil {
+		return err
+	}
+	m.missedSlot = false
+
+	return nil
+}
+
+// updateNode requests the status of the node
+func (m *ForgingManager) updateNode(ctx context.Context, node *forgingNode) {
+	node.reachable, node.healthy = false, false
+
+	statusRes, err := node.client.GetNodeStatus(ctx)
+	if err != nil || statusRes.NodeStatus == nil {
+		return
+	}
+
+	forgingRes, err := node.client.GetForgingStatus(ctx, &ForgingStatusRequest{PublicKey: m.publicKey})
+	if err != nil {
+		return
+	}
+
+	status := statusRes.NodeStatus
+	node.reachable, node.known, node.height = true, true, status.Height
+
+	var configured bool
+	node.forging = false
+	for _, forgingStatus := range forgingRes.ForgingStatus {
+		if forgingStatus.PublicKey == m.publicKey {
+			configured = true
+			node.forging = forgingStatus.Forging
+		}
+	}
+
+	node.healthy = configured && status.Loaded && !status.Syncing &&
+		status.NetworkHeight-status.Height <= m.getMaxHeightLag()
+}
+
+// detectMissedSlot checks whether the delegate forged a block in its last slot using the given client
+func (m *ForgingManager) detectMissedSlot(ctx context.Context, client *Client, events chan<- *ForgingEvent) error {
+	if m.nextSlot == 0 {
+		res, err := client.GetNextForgers(ctx, &ListOptions{Limit: 101})
+		if err != nil {
+			return err
+		}
+
+		for _, forger := range res.NextForgers {
+			if forger.PublicKey == m.publicKey {
+				m.nextSlot = forger.NextSlot
+				break
+			}
+		}
+		return nil
+	}
+
+	res, err := client.GetBlocks(ctx, &BlockRequest{ListOptions: ListOptions{Limit: 1}})
+	if err != nil {
+		return err
+	}
+	if len(res.Blocks) == 0 || res.Blocks[0].Timestamp/slotInterval <= m.nextSlot {
+		// The slot has not passed yet
+		return nil
+	}
+
+	res, err = client.GetBlocks(ctx, &BlockRequest{GeneratorPublicKey: m.publicKey, ListOptions: ListOptions{Limit: 1}})
+	if err != nil {
+		return err
+	}
+
+	if len(res.Blocks) == 0 || res.Blocks[0].Timestamp/slotInterval < m.nextSlot {
+		m.missedSlot = true
+
+		event := &ForgingEvent{Reason: fmt.Sprintf("missed slot %d", m.nextSlot)}
+		if err := sendForgingEvent(ctx, events, event); err != nil {
+			return err
+		}
+	}
+	m.nextSlot = 0
+
+	return nil
+}
+
+// setForging changes the forging status of the node and reports the change.
+// The status is set explicitly. Lisk Core 1.0 ignores it and toggles forging instead, so the result is checked and
+// toggled back if the status changed in the meantime.
+func (m *ForgingManager) setForging(ctx context.Context, node *forgingNode, forging bool, reason string,
+	events chan<- *ForgingEvent) error {
+	event := &ForgingEvent{Host: node.host, Forging: node.forging, Reason: reason}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		res, err := node.client.ToggleForging(ctx, &ForgingToggleRequest{
+			DecryptionKey: m.decryptionKey,
+			PublicKey:     m.publicKey,
+			Forging:       &forging,
+		})
+		if err != nil {
+			event.Err = err
+			break
+		}
+
+		if res.ForgingStatus == nil {
+			event.Err = errForgingNotChanged
+			break
+		}
+
+		node.forging = res.ForgingStatus.Forging
+		event.Forging, event.Err = node.forging, nil
+		if node.forging == forging {
+			break
+		}
+		event.Err = errForgingNotChanged
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := sendForgingEvent(ctx, events, event); err != nil {
+		return err
+	}
+	return event.Err
+}
+
+// getMaxHeightLag returns the maximum number of blocks a healthy node may be behind the network height
+func (m *ForgingManager) getMaxHeightLag() int {
+	if m.MaxHeightLag <= 0 {
+		return DefaultForgingMaxHeightLag
+	}
+	return m.MaxHeightLag
+}
+
+// sendForgingEvent sends the event unless the context is canceled
+func sendForgingEvent(ctx context.Context, events chan<- *ForgingEvent, event *ForgingEvent) error {
+	select {
+	case events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testForgingPublicKey = "5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09"
+)
+
+// testForgingNetwork are stand-ins for the nodes of a delegate which share the chain
+type testForgingNetwork struct {
+	t  *testing.T
+	mu sync.Mutex
+
+	nodes []*testForgingNode
+	// nextSlot is the next slot of the delegate
+	nextSlot int
+	// lastTimestamp is the timestamp of the last block of the chain
+	lastTimestamp int
+	// lastForgedTimestamp is the timestamp of the last block forged by the delegate
+	lastForgedTimestamp int
+}
+
+type testForgingNode struct {
+	server  *httptest.Server
+	height  int
+	syncing bool
+	forging bool
+	down    bool
+	// toggles is set for nodes running Lisk Core 1.0 which ignore the requested forging status
+	toggles bool
+	// requests is the number of forging status changes
+	requests int
+}
+
+func newTestForgingNetwork(t *testing.T, nodes ...*testForgingNode) *testForgingNetwork {
+	network := &testForgingNetwork{t: t, nodes: nodes}
+
+	for _, node := range nodes {
+		node.server = httptest.NewServer(network.handler(node))
+	}
+
+	return network
+}
+
+func (n *testForgingNetwork) close() {
+	for _, node := range n.nodes {
+		node.server.Close()
+	}
+}
+
+func (n *testForgingNetwork) hosts() []Host {
+	var hosts []Host
+	for _, node := range n.nodes {
+		serverURL, _ := url.Parse(node.server.URL)
+		port, _ := strconv.Atoi(serverURL.Port())
+		hosts = append(hosts, Host{Hostname: serverURL.Hostname(), Port: port})
+	}
+	return hosts
+}
+
+func (n *testForgingNetwork) handler(node *testForgingNode) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		if node.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var data interface{}
+
+		switch r.URL.Path {
+		case "/api/node/status":
+			data = map[string]interface{}{"height": node.height, "networkHeight": 100, "loaded": true,
+				"syncing": node.syncing}
+		case "/api/node/status/forging":
+			if r.Method == http.MethodPut {
+				request := &ForgingToggleRequest{}
+				json.NewDecoder(r.Body).Decode(request)
+
+				node.requests++
+				if node.toggles || request.Forging == nil {
+					node.forging = !node.forging
+				} else {
+					node.forging = *request.Forging
+				}
+				n.checkSingleForger()
+			}
+			data = []*ForgingStatus{{Forging: node.forging, PublicKey: testForgingPublicKey}}
+		case "/api/delegates/forgers":
+			data = []*DelegateWithSlot{{PublicKey: testForgingPublicKey, NextSlot: n.nextSlot}}
+		case "/api/blocks":
+			timestamp := n.lastTimestamp
+			if r.URL.Query().Get("generatorPublicKey") == testForgingPublicKey {
+				timestamp = n.lastForgedTimestamp
+			}
+			data = []*Block{{Timestamp: timestamp}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}
+}
+
+// checkSingleForger reports an error if forging is enabled on more than one running node
+func (n *testForgingNetwork) checkSingleForger() {
+	var forging int
+	for _, node := range n.nodes {
+		if node.forging && !node.down {
+			forging++
+		}
+	}
+
+	if forging > 1 {
+		n.t.Errorf("forging is enabled on %d nodes", forging)
+	}
+}
+
+func (n *testForgingNetwork) update(f func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f()
+}
+
+func testForgingManager(n *testForgingNetwork) *ForgingManager {
+	return NewForgingManager(&Config{Timeout: time.Second}, n.hosts(), testForgingPublicKey, "password")
+}
+
+func checkForgingManager(t *testing.T, manager *ForgingManager) []*ForgingEvent {
+	events := make(chan *ForgingEvent, 10)
+	if err := manager.check(context.Background(), events); err != nil {
+		t.Fatalf("ForgingManager.check() returns error: %v", err)
+	}
+	close(events)
+
+	var result []*ForgingEvent
+	for event := range events {
+		result = append(result, event)
+	}
+	return result
+}
+
+func TestForgingManager_MovesForgingFromUnsyncedNode(t *testing.T) {
+	unsynced := &testForgingNode{height: 90, syncing: true, forging: true}
+	synced := &testForgingNode{height: 100}
+
+	network := newTestForgingNetwork(t, unsynced, synced)
+	defer network.close()
+
+	events := checkForgingManager(t, testForgingManager(network))
+
+	if unsynced.forging || !synced.forging {
+		t.Errorf("forging is %v,%v; want false,true", unsynced.forging, synced.forging)
+	}
+
+	if len(events) != 2 || events[0].Forging || !events[1].Forging {
+		t.Errorf("ForgingManager.check() reports %v; want disable and enable", events)
+	}
+}
+
+func TestForgingManager_DisablesDuplicateForging(t *testing.T) {
+	first := &testForgingNode{height: 99, forging: true}
+	second := &testForgingNode{height: 100}
+
+	network := newTestForgingNetwork(t, first, second)
+	defer network.close()
+
+	// Enabled on both nodes by someone else
+	network.update(func() { second.forging = true })
+
+	manager := testForgingManager(network)
+	checkForgingManager(t, manager)
+
+	if first.forging || !second.forging {
+		t.Errorf("forging is %v,%v; want false,true", first.forging, second.forging)
+	}
+
+	if events := checkForgingManager(t, manager); len(events) != 0 {
+		t.Errorf("ForgingManager.check() reports %v; want no changes", events)
+	}
+}
+
+func TestForgingManager_WaitsForMissedSlot(t *testing.T) {
+	active := &testForgingNode{height: 100, forging: true}
+	standby := &testForgingNode{height: 100}
+
+	network := newTestForgingNetwork(t, active, standby)
+	defer network.close()
+
+	network.update(func() {
+		network.nextSlot = 100
+		network.lastTimestamp = 995
+		network.lastForgedTimestamp = 985
+	})
+
+	manager := testForgingManager(network)
+	checkForgingManager(t, manager)
+
+	// The forging node might still be forging while it's unreachable
+	network.update(func() { active.down = true })
+	if events := checkForgingManager(t, manager); len(events) != 0 || standby.forging {
+		t.Fatalf("ForgingManager.check() reports %v; want no changes", events)
+	}
+
+	network.update(func() { network.lastTimestamp = 1010 })
+	events := checkForgingManager(t, manager)
+
+	if !standby.forging {
+		t.Error("forging is not enabled on the standby node after a missed slot")
+	}
+
+	if len(events) != 2 || events[0].Reason != "missed slot 100" || events[1].Host != network.hosts()[1] {
+		t.Errorf("ForgingManager.check() reports %v; want missed slot and enable", events)
+	}
+}
+
+func TestForgingManager_setForging(t *testing.T) {
+	tests := []struct {
+		name     string
+		node     *testForgingNode
+		forging  bool
+		requests int
+	}{
+		{name: "enable", node: &testForgingNode{}, forging: true, requests: 1},
+		{name: "disable", node: &testForgingNode{forging: true}, forging: false, requests: 1},
+		{name: "already enabled", node: &testForgingNode{forging: true}, forging: true, requests: 1},
+		{name: "enable on Lisk Core 1.0", node: &testForgingNode{toggles: true}, forging: true, requests: 1},
+		{
+			name:     "already enabled on Lisk Core 1.0",
+			node:     &testForgingNode{forging: true, toggles: true},
+			forging:  true,
+			requests: 2,
+		},
+	}
+
+	for _, test := range tests {
+		network := newTestForgingNetwork(t, test.node)
+		manager := testForgingManager(network)
+
+		// The manager assumes the opposite status, e.g. because it was changed in the meantime
+		node := manager.nodes[0]
+		node.forging = !test.forging
+
+		events := make(chan *ForgingEvent, 1)
+		err := manager.setForging(context.Background(), node, test.forging, "test", events)
+
+		if err != nil || test.node.forging != test.forging || node.forging != test.forging {
+			t.Errorf("%s: ForgingManager.setForging() returns %v and forging is %v; want nil and %v", test.name, err,
+				test.node.forging, test.forging)
+		}
+		if test.node.requests != test.requests {
+			t.Errorf("%s: ForgingManager.setForging() sent %d requests; want %d", test.name, test.node.requests,
+				test.requests)
+		}
+		if event := <-events; event.Forging != test.forging || event.Err != nil {
+			t.Errorf("%s: ForgingManager.setForging() reports %+v; want forging %v", test.name, event, test.forging)
+		}
+
+		network.close()
+	}
+}
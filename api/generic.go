@@ -1,5 +1,11 @@
 package api
 
+import (
+	"strconv"
+
+	"github.com/go-resty/resty"
+)
+
 type (
 	// SortMode specifies how results are sorted
 	SortMode string
@@ -32,3 +38,29 @@ const (
 	// SortModeDescending is the raw SortMode for descending sorting
 	SortModeDescending SortMode = "DESC"
 )
+
+const (
+	// maxPageSize is the maximum number of results the API returns per request
+	maxPageSize = 100
+)
+
+// setListOptions sets the pagination query parameters of the request.
+// The limit is set to the maximum of 100 if it's not specified. The options are not modified.
+func setListOptions(req *resty.Request, options ListOptions) {
+	limit := options.Limit
+	if limit <= 0 {
+		limit = maxPageSize
+	}
+
+	offset := options.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	req.SetQueryParam("limit", strconv.Itoa(limit))
+	req.SetQueryParam("offset", strconv.Itoa(offset))
+
+	if options.Sort != "" {
+		req.SetQueryParam("sort", string(options.Sort))
+	}
+}
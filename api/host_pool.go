@@ -0,0 +1,187 @@
+package api
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+type (
+	// hostPool keeps track of the health of the hosts the client can send requests to.
+	// Hosts that fail are ejected from the pool for a while.
+	hostPool struct {
+		mu           sync.Mutex
+		hosts        []*hostState
+		ejectionTime time.Duration
+	}
+
+	// hostState is the health state of a host in the pool
+	hostState struct {
+		host Host
+		// failures is the number of consecutive failed requests
+		failures int
+		// ejectedUntil is the time until which the host is not used
+		ejectedUntil time.Time
+		// checkedAt is the time the status of the host was last checked
+		checkedAt time.Time
+	}
+)
+
+// newHostPool returns a pool containing the given hosts
+func newHostPool(hosts []Host, ejectionTime time.Duration) *hostPool {
+	pool := &hostPool{ejectionTime: ejectionTime}
+	pool.setHosts(hosts)
+
+	return pool
+}
+
+// setHosts replaces the hosts of the pool. The health state of hosts that remain in the pool is kept.
+func (p *hostPool) setHosts(hosts []Host) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	states := make([]*hostState, 0, len(hosts))
+	for _, host := range hosts {
+		state := p.find(host)
+		if state == nil {
+			state = &hostState{host: host}
+		}
+		states = append(states, state)
+	}
+	p.hosts = states
+}
+
+// allHosts returns all hosts of the pool
+func (p *hostPool) allHosts() []Host {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	hosts := make([]Host, 0, len(p.hosts))
+	for _, state := range p.hosts {
+		hosts = append(hosts, state.host)
+	}
+	return hosts
+}
+
+// healthyHosts returns the hosts of the pool which are currently not ejected
+func (p *hostPool) healthyHosts() []Host {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	var hosts []Host
+	for _, state := range p.hosts {
+		if !now.Before(state.ejectedUntil) {
+			hosts = append(hosts, state.host)
+		}
+	}
+	return hosts
+}
+
+// next returns a random healthy host other than the given one.
+// If all other hosts are ejected, the one whose ejection ends first is returned.
+// The second return value is false if the pool contains no other host.
+func (p *hostPool) next(current Host) (Host, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	var candidates []*hostState
+	var soonest *hostState
+	for _, state := range p.hosts {
+		if state.host == current {
+			continue
+		}
+		if !now.Before(state.ejectedUntil) {
+			candidates = append(candidates, state)
+		}
+		if soonest == nil || state.ejectedUntil.Before(soonest.ejectedUntil) {
+			soonest = state
+		}
+	}
+
+	if len(candidates) > 0 {
+		return candidates[rand.Intn(len(candidates))].host, true
+	}
+	if soonest != nil {
+		return soonest.host, true
+	}
+	return Host{}, false
+}
+
+// markFailed records a failed request to the host and ejects it.
+// The ejection time doubles with every consecutive failure.
+func (p *hostPool) markFailed(host Host) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state := p.find(host)
+	if state == nil {
+		return
+	}
+
+	ejectionTime := p.ejectionTime << uint(state.failures)
+	if maxEjectionTime := p.ejectionTime * maxEjectionFactor; ejectionTime > maxEjectionTime || ejectionTime <= 0 {
+		ejectionTime = maxEjectionTime
+	}
+
+	state.failures++
+	state.ejectedUntil = time.Now().Add(ejectionTime)
+	// Check the status again before the host is used after its ejection
+	state.checkedAt = time.Time{}
+}
+
+// markHealthy records a successful request to the host and readmits it to the pool
+func (p *hostPool) markHealthy(host Host) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if state := p.find(host); state != nil {
+		state.failures = 0
+		state.ejectedUntil = time.Time{}
+	}
+}
+
+// markReady records that the host is ready to serve requests and readmits it to the pool
+func (p *hostPool) markReady(host Host) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if state := p.find(host); state != nil {
+		state.failures = 0
+		state.ejectedUntil = time.Time{}
+		state.checkedAt = time.Now()
+	}
+}
+
+// checkDue returns whether the status of the host was not checked within the interval.
+// The check is recorded as done, so concurrent requests check the host only once.
+func (p *hostPool) checkDue(host Host, interval time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state := p.find(host)
+	if state == nil {
+		return false
+	}
+
+	now := time.Now()
+	if now.Sub(state.checkedAt) < interval {
+		return false
+	}
+
+	state.checkedAt = now
+	return true
+}
+
+// find returns the state of the host. The caller must hold the lock.
+func (p *hostPool) find(host Host) *hostState {
+	for _, state := range p.hosts {
+		if state.host == host {
+			return state
+		}
+	}
+	return nil
+}
@@ -0,0 +1,462 @@
+package api
+
+import (
+	"context"
+	"strconv"
+)
+
+type (
+	// pageFetcher fetches the items of the page at the given offset
+	pageFetcher func(ctx context.Context, offset, limit int) ([]interface{}, error)
+
+	// page is a fetched page of items
+	page struct {
+		items []interface{}
+		err   error
+	}
+
+	// iterator iterates over the results of a paginated list request.
+	// The next page is fetched in the background while the current one is processed.
+	//
+	// Results that are inserted while iterating can shift the following pages and are only returned once.
+	// If the height of the blockchain is pinned, results of newer blocks are skipped.
+	iterator struct {
+		cancel  context.CancelFunc
+		pages   chan page
+		items   []interface{}
+		current interface{}
+		err     error
+
+		// key returns a unique key of an item
+		key func(item interface{}) string
+		// height returns the block height of an item. It's nil if the items don't belong to blocks.
+		height func(item interface{}) int
+		// pinHeight returns the height up to which items are returned
+		pinHeight func(ctx context.Context) (int, error)
+	}
+
+	// TransactionIterator iterates over transactions
+	TransactionIterator struct{ *iterator }
+
+	// BlockIterator iterates over blocks
+	BlockIterator struct{ *iterator }
+
+	// AccountIterator iterates over accounts
+	AccountIterator struct{ *iterator }
+
+	// PeerIterator iterates over peers
+	PeerIterator struct{ *iterator }
+
+	// DappIterator iterates over Dapps
+	DappIterator struct{ *iterator }
+
+	// DelegateIterator iterates over delegates
+	DelegateIterator struct{ *iterator }
+
+	// VoterIterator iterates over the voters of a delegate
+	VoterIterator struct{ *iterator }
+
+	// VoteIterator iterates over the votes of an account
+	VoteIterator struct{ *iterator }
+)
+
+// newIterator starts fetching the pages in the background.
+// The page size is the limit of the list options or the maximum of 100.
+func newIterator(ctx context.Context, options ListOptions, fetch pageFetcher, key func(interface{}) string,
+	height func(interface{}) int, pinHeight func(context.Context) (int, error)) *iterator {
+	ctx, cancel := context.WithCancel(ctx)
+
+	it := &iterator{
+		cancel:    cancel,
+		pages:     make(chan page, 1),
+		key:       key,
+		height:    height,
+		pinHeight: pinHeight,
+	}
+
+	limit := options.Limit
+	if limit <= 0 || limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := options.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	go it.fetchPages(ctx, fetch, offset, limit)
+
+	return it
+}
+
+// fetchPages fetches the pages until the last one is reached, an error occurs or the context is canceled
+func (it *iterator) fetchPages(ctx context.Context, fetch pageFetcher, offset, limit int) {
+	defer close(it.pages)
+
+	maxHeight := -1
+	if it.pinHeight != nil && it.height != nil {
+		height, err := it.pinHeight(ctx)
+		if err != nil {
+			it.send(ctx, page{err: err})
+			return
+		}
+		maxHeight = height
+	}
+
+	seen := make(map[string]bool)
+	for {
+		items, err := fetch(ctx, offset, limit)
+		if err != nil {
+			it.send(ctx, page{err: err})
+			return
+		}
+
+		var result []interface{}
+		for _, item := range items {
+			key := it.key(item)
+			if seen[key] || (maxHeight >= 0 && it.height(item) > maxHeight) {
+				continue
+			}
+			seen[key] = true
+			result = append(result, item)
+		}
+
+		if len(result) > 0 && !it.send(ctx, page{items: result}) {
+			return
+		}
+
+		if len(items) < limit {
+			return
+		}
+		offset += len(items)
+	}
+}
+
+// send passes the page to the consumer and returns false if the context was canceled
+func (it *iterator) send(ctx context.Context, p page) bool {
+	select {
+	case it.pages <- p:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Next advances to the next result. It returns false when there are no more results or an error occurred.
+func (it *iterator) Next() bool {
+	for len(it.items) == 0 {
+		if it.err != nil {
+			return false
+		}
+
+		p, ok := <-it.pages
+		if !ok {
+			return false
+		}
+
+		if p.err != nil {
+			it.err = p.err
+			it.cancel()
+			return false
+		}
+		it.items = p.items
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+
+	return true
+}
+
+// Err returns the error that stopped the iteration
+func (it *iterator) Err() error {
+	return it.err
+}
+
+// Close stops fetching further pages. It has to be called if the iteration is stopped before Next returns false.
+func (it *iterator) Close() {
+	it.cancel()
+}
+
+// currentHeight returns the current height of the blockchain the client is connected to
+func (c *Client) currentHeight(ctx context.Context) (int, error) {
+	res, err := c.GetNodeStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if res.NodeStatus == nil {
+		return 0, errNoNodeStatus
+	}
+	return res.NodeStatus.Height, nil
+}
+
+// IterateTransactions iterates over all transactions matching the options starting at the offset of the options.
+// Transactions of blocks forged after the iteration started are skipped. The options are not modified.
+func (c *Client) IterateTransactions(ctx context.Context, options *TransactionRequest) *TransactionIterator {
+	request := TransactionRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetTransactions(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Transactions))
+		for _, transaction := range res.Transactions {
+			items = append(items, transaction)
+		}
+		return items, nil
+	}
+
+	return &TransactionIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Transaction).ID },
+		func(item interface{}) int { return item.(*Transaction).Height },
+		c.currentHeight)}
+}
+
+// Transaction returns the current transaction
+func (it *TransactionIterator) Transaction() *Transaction {
+	return it.current.(*Transaction)
+}
+
+// IterateBlocks iterates over all blocks matching the options starting at the offset of the options.
+// Blocks forged after the iteration started are skipped. The options are not modified.
+func (c *Client) IterateBlocks(ctx context.Context, options *BlockRequest) *BlockIterator {
+	request := BlockRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetBlocks(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Blocks))
+		for _, block := range res.Blocks {
+			items = append(items, block)
+		}
+		return items, nil
+	}
+
+	return &BlockIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Block).ID },
+		func(item interface{}) int { return item.(*Block).Height },
+		c.currentHeight)}
+}
+
+// Block returns the current block
+func (it *BlockIterator) Block() *Block {
+	return it.current.(*Block)
+}
+
+// IterateAccounts iterates over all accounts matching the options starting at the offset of the options.
+// The options are not modified.
+func (c *Client) IterateAccounts(ctx context.Context, options *AccountRequest) *AccountIterator {
+	request := AccountRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetAccounts(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Accounts))
+		for _, account := range res.Accounts {
+			items = append(items, account)
+		}
+		return items, nil
+	}
+
+	return &AccountIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Account).Address }, nil, nil)}
+}
+
+// Account returns the current account
+func (it *AccountIterator) Account() *Account {
+	return it.current.(*Account)
+}
+
+// IteratePeers iterates over all peers matching the options starting at the offset of the options.
+// The options are not modified.
+func (c *Client) IteratePeers(ctx context.Context, options *PeerRequest) *PeerIterator {
+	request := PeerRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetPeers(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Peers))
+		for _, peer := range res.Peers {
+			items = append(items, peer)
+		}
+		return items, nil
+	}
+
+	return &PeerIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string {
+			peer := item.(*Peer)
+			return peer.IP + ":" + strconv.Itoa(peer.WSPort)
+		}, nil, nil)}
+}
+
+// Peer returns the current peer
+func (it *PeerIterator) Peer() *Peer {
+	return it.current.(*Peer)
+}
+
+// IterateDapps iterates over all Dapps matching the options starting at the offset of the options.
+// The options are not modified.
+func (c *Client) IterateDapps(ctx context.Context, options *DappRequest) *DappIterator {
+	request := DappRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetDapps(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Dapps))
+		for _, dapp := range res.Dapps {
+			items = append(items, dapp)
+		}
+		return items, nil
+	}
+
+	return &DappIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Dapp).TransactionID }, nil, nil)}
+}
+
+// Dapp returns the current Dapp
+func (it *DappIterator) Dapp() *Dapp {
+	return it.current.(*Dapp)
+}
+
+// IterateSearchDelegates iterates over all delegates whose username matches the search starting at the offset
+// of the list options. The list options are not modified.
+func (c *Client) IterateSearchDelegates(ctx context.Context, username string, listOptions *ListOptions) *DelegateIterator {
+	options := ListOptions{}
+	if listOptions != nil {
+		options = *listOptions
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageOptions := options
+		pageOptions.Offset, pageOptions.Limit = offset, limit
+
+		res, err := c.SearchDelegates(ctx, username, &pageOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.Delegates))
+		for _, delegate := range res.Delegates {
+			items = append(items, delegate)
+		}
+		return items, nil
+	}
+
+	return &DelegateIterator{newIterator(ctx, options, fetch,
+		func(item interface{}) string { return item.(*Delegate).Username }, nil, nil)}
+}
+
+// Delegate returns the current delegate
+func (it *DelegateIterator) Delegate() *Delegate {
+	return it.current.(*Delegate)
+}
+
+// IterateDelegateVoters iterates over all voters of the delegate starting at the offset of the options.
+// The options are not modified.
+func (c *Client) IterateDelegateVoters(ctx context.Context, options *DelegateVoterRequest) *VoterIterator {
+	request := DelegateVoterRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetDelegateVoters(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.DelegateWithVoters.Voters))
+		for _, voter := range res.DelegateWithVoters.Voters {
+			items = append(items, voter)
+		}
+		return items, nil
+	}
+
+	return &VoterIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Voter).Address }, nil, nil)}
+}
+
+// Voter returns the current voter
+func (it *VoterIterator) Voter() *Voter {
+	return it.current.(*Voter)
+}
+
+// IterateVotes iterates over all votes of the account starting at the offset of the options.
+// The options are not modified.
+func (c *Client) IterateVotes(ctx context.Context, options *VoterRequest) *VoteIterator {
+	request := VoterRequest{}
+	if options != nil {
+		request = *options
+	}
+
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		pageRequest := request
+		pageRequest.Offset, pageRequest.Limit = offset, limit
+
+		res, err := c.GetVotes(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]interface{}, 0, len(res.VoteData.Votes))
+		for _, vote := range res.VoteData.Votes {
+			items = append(items, vote)
+		}
+		return items, nil
+	}
+
+	return &VoteIterator{newIterator(ctx, request.ListOptions, fetch,
+		func(item interface{}) string { return item.(*Vote).Address }, nil, nil)}
+}
+
+// Vote returns the current vote
+func (it *VoteIterator) Vote() *Vote {
+	return it.current.(*Vote)
+}
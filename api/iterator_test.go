@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// testPages returns a page fetcher that returns the pages in order of the requests
+func testPages(pages ...[]interface{}) pageFetcher {
+	request := 0
+	return func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		if request >= len(pages) {
+			return nil, errors.New("unexpected page request")
+		}
+		request++
+		return pages[request-1], nil
+	}
+}
+
+func collectIterator(it *iterator) []interface{} {
+	var result []interface{}
+	for it.Next() {
+		result = append(result, it.current)
+	}
+	return result
+}
+
+func TestIterator(t *testing.T) {
+	key := func(item interface{}) string { return item.(string) }
+
+	tests := []struct {
+		name  string
+		limit int
+		pages [][]interface{}
+		want  []string
+	}{
+		{
+			name:  "single page",
+			limit: 3,
+			pages: [][]interface{}{{"a", "b"}},
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "full last page",
+			limit: 2,
+			pages: [][]interface{}{{"a", "b"}, {"c", "d"}, {}},
+			want:  []string{"a", "b", "c", "d"},
+		},
+		{
+			name:  "shifted by inserted results",
+			limit: 2,
+			pages: [][]interface{}{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"e"}},
+			want:  []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:  "page of duplicates",
+			limit: 2,
+			pages: [][]interface{}{{"a", "b"}, {"a", "b"}, {"c"}},
+			want:  []string{"a", "b", "c"},
+		},
+	}
+
+	for _, test := range tests {
+		it := newIterator(context.Background(), ListOptions{Limit: test.limit}, testPages(test.pages...), key, nil,
+			nil)
+		result := collectIterator(it)
+
+		if it.Err() != nil || len(result) != len(test.want) {
+			t.Errorf("%s: iterator returns %v,%v; want %v", test.name, result, it.Err(), test.want)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.want[i] {
+				t.Errorf("%s: iterator returns %v; want %v", test.name, result, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	key := func(item interface{}) string { return item.(string) }
+
+	it := newIterator(context.Background(), ListOptions{Limit: 2}, testPages([]interface{}{"a", "b"}), key, nil,
+		nil)
+	result := collectIterator(it)
+
+	if len(result) != 2 || it.Err() == nil {
+		t.Errorf("iterator returns %v,%v; want 2 results and error", result, it.Err())
+	}
+	if it.Next() {
+		t.Error("iterator.Next() returns true after an error; want false")
+	}
+
+	pinErr := errors.New("no status")
+	it = newIterator(context.Background(), ListOptions{}, testPages(), key,
+		func(item interface{}) int { return 0 },
+		func(ctx context.Context) (int, error) { return 0, pinErr })
+
+	if result := collectIterator(it); len(result) != 0 || it.Err() != pinErr {
+		t.Errorf("iterator returns %v,%v; want error %v", result, it.Err(), pinErr)
+	}
+}
+
+func TestClient_IterateTransactionsPinsHeight(t *testing.T) {
+	// The chain grows by one block while iterating, so the result of the height 11 shifts the pages
+	var requests int
+	server := newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": testData(&NodeStatus{Height: 10, Loaded: true}),
+		"/api/transactions": func(r *http.Request) (interface{}, int) {
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+			chain := []*Transaction{{ID: "10", Height: 10}, {ID: "9", Height: 9}, {ID: "8", Height: 8}}
+			if requests > 0 {
+				chain = append([]*Transaction{{ID: "11", Height: 11}}, chain...)
+			}
+			requests++
+
+			if offset > len(chain) {
+				offset = len(chain)
+			}
+			end := offset + limit
+			if end > len(chain) {
+				end = len(chain)
+			}
+			return chain[offset:end], http.StatusOK
+		},
+	})
+	defer server.Close()
+
+	it := testClient(server).IterateTransactions(context.Background(), &TransactionRequest{
+		ListOptions: ListOptions{Limit: 2},
+	})
+	defer it.Close()
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Transaction().ID)
+	}
+
+	if it.Err() != nil || len(ids) != 3 || ids[0] != "10" || ids[1] != "9" || ids[2] != "8" {
+		t.Errorf("TransactionIterator returns %v,%v; want [10 9 8]", ids, it.Err())
+	}
+}
+
+func TestIterator_Close(t *testing.T) {
+	pages := make(chan struct{})
+	fetch := func(ctx context.Context, offset, limit int) ([]interface{}, error) {
+		select {
+		case pages <- struct{}{}:
+		case <-ctx.Done():
+		}
+		return []interface{}{strconv.Itoa(offset)}, nil
+	}
+
+	it := newIterator(context.Background(), ListOptions{Limit: 1}, fetch,
+		func(item interface{}) string { return item.(string) }, nil, nil)
+	<-pages
+
+	if !it.Next() || it.current != "0" {
+		t.Fatalf("iterator.Next() returns %v; want first result", it.current)
+	}
+	it.Close()
+
+	// The fetcher stops after the close, so no further pages are requested
+	for it.Next() {
+	}
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+const (
+	// maxAccountVotes is the maximum number of delegates an account can vote for
+	maxAccountVotes = 101
+)
+
+// Preflight checks the transaction against the current state of the sender account before it is broadcast.
+// It reports insufficient balances, missing or unexpected second signatures, votes for delegates that are already
+// voted for, unvotes of delegates that are not voted for, exceeding the maximum number of votes and delegate
+// usernames that are already taken.
+// All problems including the violations of the stateless validation rules are returned as
+// transactions.ValidationErrors. Any other error means that the state could not be fetched.
+func Preflight(ctx context.Context, client *Client, transaction *transactions.Transaction) error {
+	errs := transaction.Validate()
+	if len(errs.Field("senderPublicKey")) > 0 {
+		return errs
+	}
+
+	senderPublicKey := hex.EncodeToString(transaction.SenderPublicKey)
+	senderAddress := crypto.GetAddressFromPublicKey(transaction.SenderPublicKey)
+
+	res, err := client.GetAccounts(ctx, &AccountRequest{Address: senderAddress})
+	if err != nil {
+		return err
+	}
+
+	// Accounts which never received a transaction are unknown to the node
+	account := &Account{Address: senderAddress, PublicKey: senderPublicKey}
+	if len(res.Accounts) > 0 {
+		account = res.Accounts[0]
+	}
+
+	errs = append(errs, checkBalance(transaction, account)...)
+	errs = append(errs, checkSecondSignature(transaction, account)...)
+
+	switch asset := transaction.Asset.(type) {
+	case *transactions.RegisterSecondSignatureAsset:
+		if account.SecondPublicKey != "" {
+			errs = appendPreflightError(errs, "asset.signature", "account already has a second signature")
+		}
+	case *transactions.RegisterDelegateAsset:
+		delegateErrs, err := checkDelegateRegistration(ctx, client, asset, senderPublicKey)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, delegateErrs...)
+	case *transactions.CastVoteAsset:
+		voteErrs, err := checkVotes(ctx, client, asset, account)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, voteErrs...)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// checkBalance checks whether the account can pay the amount and the fee of the transaction
+func checkBalance(transaction *transactions.Transaction, account *Account) transactions.ValidationErrors {
+	fee, err := transaction.Fee()
+	if err != nil {
+		return appendPreflightError(nil, "fee", err.Error())
+	}
+
+	required := transaction.Amount + fee
+	if account.UnconfirmedBalance < 0 || uint64(account.UnconfirmedBalance) < required {
+		return appendPreflightError(nil, "amount", fmt.Sprintf("insufficient balance: %d required, %d available",
+			required, account.UnconfirmedBalance))
+	}
+
+	return nil
+}
+
+// checkSecondSignature checks whether the transaction has a valid second signature if the account requires one
+func checkSecondSignature(transaction *transactions.Transaction, account *Account) transactions.ValidationErrors {
+	hasSecondSignature := len(transaction.SecondSignature()) > 0
+
+	if account.SecondPublicKey == "" {
+		if hasSecondSignature {
+			return appendPreflightError(nil, "secondSignature", "account has no second signature")
+		}
+		return nil
+	}
+
+	if !hasSecondSignature {
+		return appendPreflightError(nil, "secondSignature", "account requires a second signature")
+	}
+
+	secondPublicKey, err := hex.DecodeString(account.SecondPublicKey)
+	if err != nil {
+		return appendPreflightError(nil, "secondSignature", "account has an invalid second public key")
+	}
+
+	if valid, _ := transaction.VerifySecondSignature(secondPublicKey); !valid {
+		return appendPreflightError(nil, "secondSignature", "does not match the second public key of the account")
+	}
+
+	return nil
+}
+
+// checkDelegateRegistration checks whether the sender is no delegate yet and the username is available.
+// The public key of the transaction is used, because accounts that never sent a transaction have none on the node.
+func checkDelegateRegistration(ctx context.Context, client *Client, asset *transactions.RegisterDelegateAsset,
+	senderPublicKey string) (transactions.ValidationErrors, error) {
+	var errs transactions.ValidationErrors
+
+	res, err := client.GetDelegate(ctx, &DelegateRequest{PublicKey: senderPublicKey})
+	if err != nil {
+		return nil, err
+	}
+	if res.Delegate != nil {
+		errs = appendPreflightError(errs, "asset.delegate", "account is already a delegate")
+	}
+
+	res, err = client.GetDelegate(ctx, &DelegateRequest{Username: asset.Username})
+	if err != nil {
+		return nil, err
+	}
+	if res.Delegate != nil {
+		errs = appendPreflightError(errs, "asset.delegate.username", "username is already taken")
+	}
+
+	return errs, nil
+}
+
+// checkVotes checks the votes and unvotes against the current votes of the account
+func checkVotes(ctx context.Context, client *Client, asset *transactions.CastVoteAsset,
+	account *Account) (transactions.ValidationErrors, error) {
+	var errs transactions.ValidationErrors
+
+	var votes []string
+	it := client.IterateVotes(ctx, &VoterRequest{Address: account.Address})
+	for it.Next() {
+		votes = append(votes, it.Vote().PublicKey)
+	}
+	it.Close()
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, vote := range asset.Votes {
+		publicKey := hex.EncodeToString(vote)
+		if containsString(votes, publicKey) {
+			errs = appendPreflightError(errs, "asset.votes", "already voted for "+publicKey)
+			continue
+		}
+
+		res, err := client.GetDelegate(ctx, &DelegateRequest{PublicKey: publicKey})
+		if err != nil {
+			return nil, err
+		}
+		if res.Delegate == nil {
+			errs = appendPreflightError(errs, "asset.votes", publicKey+" is not a delegate")
+		}
+	}
+
+	for _, unvote := range asset.Unvotes {
+		publicKey := hex.EncodeToString(unvote)
+		if !containsString(votes, publicKey) {
+			errs = appendPreflightError(errs, "asset.votes", "not voted for "+publicKey)
+		}
+	}
+
+	if len(votes)+len(asset.Votes)-len(asset.Unvotes) > maxAccountVotes {
+		errs = appendPreflightError(errs, "asset.votes", fmt.Sprintf("exceeds the maximum of %d votes per account",
+			maxAccountVotes))
+	}
+
+	return errs, nil
+}
+
+// appendPreflightError appends an error for the field
+func appendPreflightError(errs transactions.ValidationErrors, field, message string) transactions.ValidationErrors {
+	return append(errs, &transactions.ValidationError{Field: field, Message: message})
+}
@@ -0,0 +1,318 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+var (
+	preflightSigner       = crypto.NewPassphraseSigner("preflight")
+	preflightSecondSigner = crypto.NewPassphraseSigner("preflight second")
+	preflightAddress      = crypto.GetAddressFromPublicKey(preflightSigner.PublicKey())
+)
+
+// testDelegateKey returns the public key of the i-th delegate of the test
+func testDelegateKey(i int) []byte {
+	key := make([]byte, 32)
+	key[0], key[1] = byte(i), byte(i>>8)
+	return key
+}
+
+// testDelegateKeys returns the public keys of the delegates in [from, to)
+func testDelegateKeys(from, to int) [][]byte {
+	var keys [][]byte
+	for i := from; i < to; i++ {
+		keys = append(keys, testDelegateKey(i))
+	}
+	return keys
+}
+
+// testAccountState is the state of the sender account on a node stand-in
+type testAccountState struct {
+	// account is the sender account or nil if it is unknown
+	account *Account
+	// votes are the delegates the account voted for
+	votes [][]byte
+	// delegates are the registered delegates by public key and username
+	delegates map[string]*Delegate
+}
+
+// newTestPreflightServer returns a node stand-in which serves the state of the sender account
+func newTestPreflightServer(t *testing.T, state *testAccountState) *httptest.Server {
+	return newTestAPIServer(t, testAPIRoutes{
+		"/api/accounts": func(r *http.Request) (interface{}, int) {
+			if state.account == nil || r.URL.Query().Get("address") != state.account.Address {
+				return []*Account{}, http.StatusOK
+			}
+			return []*Account{state.account}, http.StatusOK
+		},
+		"/api/votes": func(r *http.Request) (interface{}, int) {
+			data := &VotesData{Address: preflightAddress, Votes: []*Vote{}}
+			if offset := r.URL.Query().Get("offset"); offset == "" || offset == "0" {
+				for _, vote := range state.votes {
+					data.Votes = append(data.Votes, &Vote{PublicKey: hex.EncodeToString(vote),
+						Address: crypto.GetAddressFromPublicKey(vote)})
+				}
+			}
+			return data, http.StatusOK
+		},
+		"/api/delegates": func(r *http.Request) (interface{}, int) {
+			key := r.URL.Query().Get("publicKey") + r.URL.Query().Get("username")
+			if key == "" {
+				// Like Lisk Core, all delegates are returned without filter
+				result := []*Delegate{}
+				for _, delegate := range state.delegates {
+					result = append(result, delegate)
+				}
+				return result, http.StatusOK
+			}
+
+			if delegate, ok := state.delegates[key]; ok {
+				return []*Delegate{delegate}, http.StatusOK
+			}
+			return []*Delegate{}, http.StatusOK
+		},
+	})
+}
+
+// testAccount returns the sender account with the given balance and second public key
+func testAccount(balance int64, secondSigner crypto.Signer) *Account {
+	account := &Account{
+		Address:            preflightAddress,
+		PublicKey:          hex.EncodeToString(preflightSigner.PublicKey()),
+		Balance:            balance,
+		UnconfirmedBalance: balance,
+	}
+	if secondSigner != nil {
+		account.SecondPublicKey = hex.EncodeToString(secondSigner.PublicKey())
+	}
+	return account
+}
+
+// testDelegates returns the delegates with the given public keys and a delegate with the username taken
+func testDelegates(keys [][]byte) map[string]*Delegate {
+	delegates := map[string]*Delegate{"taken": {Username: "taken"}}
+	for _, key := range keys {
+		delegates[hex.EncodeToString(key)] = &Delegate{Account: &Account{PublicKey: hex.EncodeToString(key)}}
+	}
+	return delegates
+}
+
+func TestPreflight(t *testing.T) {
+	transfer := func(amount uint64, secondSigner crypto.Signer) func() (*transactions.Transaction, error) {
+		return func() (*transactions.Transaction, error) {
+			return transactions.NewTransaction(network.Testnet, "104666L", amount, preflightSigner, secondSigner, 0)
+		}
+	}
+	vote := func(votes, unvotes [][]byte) func() (*transactions.Transaction, error) {
+		return func() (*transactions.Transaction, error) {
+			return transactions.NewVoteTransaction(network.Testnet, preflightSigner, nil, 0, votes, unvotes)
+		}
+	}
+	registerDelegate := func(username string) func() (*transactions.Transaction, error) {
+		return func() (*transactions.Transaction, error) {
+			return transactions.NewDelegateRegistrationTransaction(network.Testnet, preflightSigner, nil, 0, username)
+		}
+	}
+
+	sender := hex.EncodeToString(preflightSigner.PublicKey())
+	delegates := testDelegates(testDelegateKeys(0, 200))
+
+	tests := []struct {
+		name        string
+		transaction func() (*transactions.Transaction, error)
+		state       *testAccountState
+		want        []string
+	}{
+		{
+			name:        "sufficient balance",
+			transaction: transfer(1, nil),
+			state:       &testAccountState{account: testAccount(10000001, nil)},
+		},
+		{
+			name:        "insufficient balance",
+			transaction: transfer(1, nil),
+			state:       &testAccountState{account: testAccount(10000000, nil)},
+			want:        []string{"amount"},
+		},
+		{
+			name:        "unknown account",
+			transaction: transfer(1, nil),
+			state:       &testAccountState{},
+			want:        []string{"amount"},
+		},
+		{
+			name:        "second signature",
+			transaction: transfer(1, preflightSecondSigner),
+			state:       &testAccountState{account: testAccount(100000000, preflightSecondSigner)},
+		},
+		{
+			name:        "missing second signature",
+			transaction: transfer(1, nil),
+			state:       &testAccountState{account: testAccount(100000000, preflightSecondSigner)},
+			want:        []string{"secondSignature"},
+		},
+		{
+			name:        "unexpected second signature",
+			transaction: transfer(1, preflightSecondSigner),
+			state:       &testAccountState{account: testAccount(100000000, nil)},
+			want:        []string{"secondSignature"},
+		},
+		{
+			name:        "wrong second signature",
+			transaction: transfer(1, crypto.NewPassphraseSigner("other")),
+			state:       &testAccountState{account: testAccount(100000000, preflightSecondSigner)},
+			want:        []string{"secondSignature"},
+		},
+		{
+			name: "registered second signature",
+			transaction: func() (*transactions.Transaction, error) {
+				return transactions.NewSecondSignatureTransaction(network.Testnet, preflightSigner,
+					preflightSecondSigner.PublicKey(), 0)
+			},
+			state: &testAccountState{account: testAccount(1000000000, preflightSecondSigner)},
+			want:  []string{"secondSignature", "asset.signature"},
+		},
+		{
+			name:        "delegate registration",
+			transaction: registerDelegate("free"),
+			state:       &testAccountState{account: testAccount(10000000000, nil), delegates: delegates},
+		},
+		{
+			name:        "delegate registration of a receive-only account",
+			transaction: registerDelegate("free"),
+			state: &testAccountState{
+				account:   &Account{Address: preflightAddress, Balance: 10000000000, UnconfirmedBalance: 10000000000},
+				delegates: delegates,
+			},
+		},
+		{
+			name:        "registered delegate",
+			transaction: registerDelegate("free"),
+			state: &testAccountState{
+				account:   testAccount(10000000000, nil),
+				delegates: map[string]*Delegate{sender: {Username: "preflight"}},
+			},
+			want: []string{"asset.delegate"},
+		},
+		{
+			name:        "username taken",
+			transaction: registerDelegate("taken"),
+			state:       &testAccountState{account: testAccount(10000000000, nil), delegates: delegates},
+			want:        []string{"asset.delegate.username"},
+		},
+		{
+			name:        "votes",
+			transaction: vote(testDelegateKeys(1, 3), testDelegateKeys(0, 1)),
+			state: &testAccountState{
+				account:   testAccount(100000000, nil),
+				votes:     testDelegateKeys(0, 1),
+				delegates: delegates,
+			},
+		},
+		{
+			name:        "already voted",
+			transaction: vote(testDelegateKeys(0, 2), nil),
+			state: &testAccountState{
+				account:   testAccount(100000000, nil),
+				votes:     testDelegateKeys(0, 1),
+				delegates: delegates,
+			},
+			want: []string{"asset.votes"},
+		},
+		{
+			name:        "vote for no delegate",
+			transaction: vote(testDelegateKeys(200, 201), nil),
+			state:       &testAccountState{account: testAccount(100000000, nil), delegates: delegates},
+			want:        []string{"asset.votes"},
+		},
+		{
+			name:        "unvote without vote",
+			transaction: vote(nil, testDelegateKeys(0, 2)),
+			state: &testAccountState{
+				account:   testAccount(100000000, nil),
+				votes:     testDelegateKeys(0, 1),
+				delegates: delegates,
+			},
+			want: []string{"asset.votes"},
+		},
+		{
+			name:        "vote limit",
+			transaction: vote(testDelegateKeys(100, 101), nil),
+			state: &testAccountState{
+				account:   testAccount(100000000, nil),
+				votes:     testDelegateKeys(0, 100),
+				delegates: delegates,
+			},
+		},
+		{
+			name:        "exceeded vote limit",
+			transaction: vote(testDelegateKeys(100, 102), nil),
+			state: &testAccountState{
+				account:   testAccount(100000000, nil),
+				votes:     testDelegateKeys(0, 100),
+				delegates: delegates,
+			},
+			want: []string{"asset.votes"},
+		},
+		{
+			name:        "several problems",
+			transaction: vote(testDelegateKeys(0, 1), nil),
+			state: &testAccountState{
+				account:   testAccount(0, preflightSecondSigner),
+				votes:     testDelegateKeys(0, 1),
+				delegates: delegates,
+			},
+			want: []string{"amount", "secondSignature", "asset.votes"},
+		},
+	}
+
+	for _, test := range tests {
+		transaction, err := test.transaction()
+		if err != nil {
+			t.Errorf("%s: cannot create transaction: %v", test.name, err)
+			continue
+		}
+
+		server := newTestPreflightServer(t, test.state)
+
+		err = Preflight(context.Background(), testClient(server), transaction)
+
+		var fields []string
+		if errs, ok := err.(transactions.ValidationErrors); ok {
+			for _, validationErr := range errs {
+				fields = append(fields, validationErr.Field)
+			}
+		} else if err != nil {
+			t.Errorf("%s: Preflight() returns error %v; want validation errors", test.name, err)
+		}
+
+		if got, want := strings.Join(fields, " "), strings.Join(test.want, " "); got != want {
+			t.Errorf("%s: Preflight() reports problems with %q (%v); want %q", test.name, got, err, want)
+		}
+
+		server.Close()
+	}
+}
+
+func TestPreflight_InvalidSender(t *testing.T) {
+	// The state of the sender is not requested if the sender is invalid
+	server := newTestAPIServer(t, testAPIRoutes{})
+	defer server.Close()
+
+	transaction := &transactions.Transaction{Type: transactions.TransactionTypeNormal, Amount: 1,
+		RecipientID: "104666L", Network: network.Testnet}
+
+	errs, ok := Preflight(context.Background(), testClient(server), transaction).(transactions.ValidationErrors)
+	if !ok || len(errs.Field("senderPublicKey")) == 0 {
+		t.Errorf("Preflight() returns %v; want error for the sender public key", errs)
+	}
+}
@@ -0,0 +1,405 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// QuorumClient sends read requests to several hosts of the pool at once and only returns results
+	// a quorum of the hosts agrees on.
+	// Hosts that are forked or behind the other hosts are not used.
+	QuorumClient struct {
+		client *Client
+		// Size is the number of hosts a request is sent to
+		Size int
+		// Threshold is the number of hosts that have to return the same result
+		Threshold int
+		// MaxHeightLag is the maximum number of blocks a host may be behind the highest host of the quorum.
+		// Results that depend on the latest blocks can differ between hosts at different heights.
+		MaxHeightLag int
+	}
+
+	// QuorumError is returned when the hosts of a quorum read don't agree on a result
+	QuorumError struct {
+		// Threshold is the number of hosts that had to agree
+		Threshold int
+		// Groups are the hosts grouped by the result they returned, largest group first
+		Groups [][]Host
+		// Results are the results of the groups
+		Results []interface{}
+		// Differences describe where the result of each group differs from the result of the largest group
+		Differences []string
+		// Failures are the errors of the hosts whose requests failed
+		Failures map[Host]error
+	}
+
+	// quorumHostStatus is the status of a host that is a quorum candidate
+	quorumHostStatus struct {
+		host   Host
+		status *NodeStatus
+	}
+)
+
+const (
+	// DefaultQuorumMaxHeightLag is the default number of blocks a host may be behind the others in a quorum read
+	DefaultQuorumMaxHeightLag = 1
+)
+
+// Quorum returns a client that sends read requests to size hosts of the pool and requires threshold of them
+// to return the same result. A majority of the size is required if threshold is 0.
+func (c *Client) Quorum(size, threshold int) *QuorumClient {
+	if threshold <= 0 {
+		threshold = size/2 + 1
+	}
+
+	return &QuorumClient{
+		client:       c,
+		Size:         size,
+		Threshold:    threshold,
+		MaxHeightLag: DefaultQuorumMaxHeightLag,
+	}
+}
+
+// GetAccounts searches for accounts like Client.GetAccounts and returns the result the quorum agrees on.
+func (q *QuorumClient) GetAccounts(ctx context.Context, options *AccountRequest) (*AccountResponse, error) {
+	result, err := q.read(ctx, func(ctx context.Context, client *Client) (interface{}, interface{}, error) {
+		var hostOptions *AccountRequest
+		if options != nil {
+			copied := *options
+			hostOptions = &copied
+		}
+
+		res, err := client.GetAccounts(ctx, hostOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+		return res, res.Accounts, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*AccountResponse), nil
+}
+
+// GetTransactions searches for transactions like Client.GetTransactions and returns the result the quorum agrees on.
+// The confirmations of the transactions are not compared as they depend on the height of the host.
+func (q *QuorumClient) GetTransactions(ctx context.Context, options *TransactionRequest) (
+	*TransactionsResponse, error) {
+	result, err := q.read(ctx, func(ctx context.Context, client *Client) (interface{}, interface{}, error) {
+		var hostOptions *TransactionRequest
+		if options != nil {
+			copied := *options
+			hostOptions = &copied
+		}
+
+		res, err := client.GetTransactions(ctx, hostOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		compared := make([]Transaction, 0, len(res.Transactions))
+		for _, transaction := range res.Transactions {
+			copied := *transaction
+			copied.Confirmations = 0
+			compared = append(compared, copied)
+		}
+		return res, compared, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*TransactionsResponse), nil
+}
+
+// GetBlocks searches for blocks like Client.GetBlocks and returns the result the quorum agrees on.
+// The confirmations of the blocks are not compared as they depend on the height of the host.
+func (q *QuorumClient) GetBlocks(ctx context.Context, options *BlockRequest) (*BlockResponse, error) {
+	result, err := q.read(ctx, func(ctx context.Context, client *Client) (interface{}, interface{}, error) {
+		var hostOptions *BlockRequest
+		if options != nil {
+			copied := *options
+			hostOptions = &copied
+		}
+
+		res, err := client.GetBlocks(ctx, hostOptions)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		compared := make([]Block, 0, len(res.Blocks))
+		for _, block := range res.Blocks {
+			copied := *block
+			copied.Confirmations = 0
+			compared = append(compared, copied)
+		}
+		return res, compared, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*BlockResponse), nil
+}
+
+// read sends the request to the hosts of the quorum and returns the result the quorum agrees on.
+// The request returns the result and the data that is compared between the hosts.
+func (q *QuorumClient) read(ctx context.Context,
+	request func(ctx context.Context, client *Client) (interface{}, interface{}, error)) (interface{}, error) {
+	if q.Threshold <= 0 || q.Threshold > q.Size {
+		return nil, errors.New("quorum threshold must be between 1 and the quorum size")
+	}
+
+	hosts, err := q.selectHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, len(hosts))
+	keys := make([]string, len(hosts))
+	errs := make([]error, len(hosts))
+
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func(i int, host Host) {
+			defer wg.Done()
+
+			result, compared, err := request(ctx, q.client.forHost(host))
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			key, err := json.Marshal(compared)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			results[i] = result
+			keys[i] = string(key)
+		}(i, host)
+	}
+	wg.Wait()
+
+	quorumErr := &QuorumError{
+		Threshold: q.Threshold,
+		Failures:  make(map[Host]error),
+	}
+
+	var groupKeys []string
+	groupIndex := make(map[string]int)
+	for i, host := range hosts {
+		if errs[i] != nil {
+			quorumErr.Failures[host] = errs[i]
+			continue
+		}
+
+		index, ok := groupIndex[keys[i]]
+		if !ok {
+			index = len(quorumErr.Groups)
+			groupIndex[keys[i]] = index
+			quorumErr.Groups = append(quorumErr.Groups, nil)
+			quorumErr.Results = append(quorumErr.Results, results[i])
+			groupKeys = append(groupKeys, keys[i])
+		}
+		quorumErr.Groups[index] = append(quorumErr.Groups[index], host)
+	}
+
+	// Sort the largest group first
+	for i := 1; i < len(quorumErr.Groups); i++ {
+		for j := i; j > 0 && len(quorumErr.Groups[j]) > len(quorumErr.Groups[j-1]); j-- {
+			quorumErr.Groups[j], quorumErr.Groups[j-1] = quorumErr.Groups[j-1], quorumErr.Groups[j]
+			quorumErr.Results[j], quorumErr.Results[j-1] = quorumErr.Results[j-1], quorumErr.Results[j]
+			groupKeys[j], groupKeys[j-1] = groupKeys[j-1], groupKeys[j]
+		}
+	}
+
+	if len(quorumErr.Groups) > 0 && len(quorumErr.Groups[0]) >= q.Threshold {
+		return quorumErr.Results[0], nil
+	}
+
+	for i := range quorumErr.Groups {
+		quorumErr.Differences = append(quorumErr.Differences, describeDifference(groupKeys[0], groupKeys[i]))
+	}
+
+	return nil, quorumErr
+}
+
+// selectHosts returns up to Size healthy hosts which are on the same fork and not behind the other hosts.
+// The fork is determined by the broadhash most of the hosts report.
+func (q *QuorumClient) selectHosts(ctx context.Context) ([]Host, error) {
+	hosts := q.client.HealthyHosts()
+	rand.Shuffle(len(hosts), func(i, j int) { hosts[i], hosts[j] = hosts[j], hosts[i] })
+
+	statuses := make([]*NodeStatus, len(hosts))
+
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Add(1)
+		go func(i int, host Host) {
+			defer wg.Done()
+
+			res, err := q.client.forHost(host).GetNodeStatus(ctx)
+			if err == nil && res.NodeStatus != nil && res.NodeStatus.Loaded && !res.NodeStatus.Syncing {
+				statuses[i] = res.NodeStatus
+			}
+		}(i, host)
+	}
+	wg.Wait()
+
+	broadhashes := make(map[string]int)
+	for _, status := range statuses {
+		if status != nil {
+			broadhashes[status.Broadhash]++
+		}
+	}
+
+	var broadhash string
+	for hash, count := range broadhashes {
+		if count > broadhashes[broadhash] || (count == broadhashes[broadhash] && hash < broadhash) {
+			broadhash = hash
+		}
+	}
+
+	var candidates []quorumHostStatus
+	maxHeight := 0
+	for i, status := range statuses {
+		if status == nil || status.Broadhash != broadhash {
+			continue
+		}
+		candidates = append(candidates, quorumHostStatus{host: hosts[i], status: status})
+		if status.Height > maxHeight {
+			maxHeight = status.Height
+		}
+	}
+
+	var result []Host
+	for _, candidate := range candidates {
+		if candidate.status.Height >= maxHeight-q.MaxHeightLag && len(result) < q.Size {
+			result = append(result, candidate.host)
+		}
+	}
+
+	if len(result) < q.Threshold {
+		return nil, fmt.Errorf("only %d of %d required hosts are in sync", len(result), q.Threshold)
+	}
+	return result, nil
+}
+
+// forHost returns a client that sends all requests to the given host without failover
+func (c *Client) forHost(host Host) *Client {
+	config := *c.config
+	config.RandomHost = false
+	config.Host = host
+	config.Discovery = nil
+
+	return &Client{
+		restClient: c.restClient,
+		config:     &config,
+		pool:       newHostPool([]Host{host}, config.getEjectionTime()),
+		host:       host,
+	}
+}
+
+// Error lists the hosts grouped by the result they returned and the hosts that failed
+func (e *QuorumError) Error() string {
+	b := strings.Builder{}
+
+	b.WriteString(fmt.Sprintf("no quorum of %d hosts agreeing on a result", e.Threshold))
+	for i, group := range e.Groups {
+		b.WriteString(fmt.Sprintf("\n\tresult %d: %s", i+1, joinHosts(group)))
+		if i > 0 && i < len(e.Differences) {
+			b.WriteString(" (differs at " + e.Differences[i] + ")")
+		}
+	}
+	for host, err := range e.Failures {
+		b.WriteString(fmt.Sprintf("\n\tfailed %s: %v", host.GetHostURL(), err))
+	}
+
+	return b.String()
+}
+
+// describeDifference returns the path and values of the first difference between two JSON documents
+func describeDifference(a, b string) string {
+	var aValue, bValue interface{}
+	if json.Unmarshal([]byte(a), &aValue) != nil || json.Unmarshal([]byte(b), &bValue) != nil {
+		return "unknown"
+	}
+
+	if path, ok := findDifference("", aValue, bValue); ok {
+		return path
+	}
+	return "none"
+}
+
+// findDifference returns the path and values of the first difference between two decoded JSON values
+func findDifference(path string, a, b interface{}) (string, bool) {
+	switch aValue := a.(type) {
+	case map[string]interface{}:
+		if bValue, ok := b.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(aValue)+len(bValue))
+			for key := range aValue {
+				keys = append(keys, key)
+			}
+			for key := range bValue {
+				if _, ok := aValue[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				if difference, ok := findDifference(path+"."+key, aValue[key], bValue[key]); ok {
+					return difference, true
+				}
+			}
+			return "", false
+		}
+	case []interface{}:
+		if bValue, ok := b.([]interface{}); ok {
+			if len(aValue) != len(bValue) {
+				return fmt.Sprintf("%s: %d items != %d items", rootPath(path), len(aValue), len(bValue)), true
+			}
+
+			for i := range aValue {
+				if difference, ok := findDifference(fmt.Sprintf("%s[%d]", path, i), aValue[i], bValue[i]); ok {
+					return difference, true
+				}
+			}
+			return "", false
+		}
+	default:
+		if a == b {
+			return "", false
+		}
+	}
+
+	return fmt.Sprintf("%s: %v != %v", rootPath(path), a, b), true
+}
+
+// rootPath returns the path or a placeholder for the root of a JSON document
+func rootPath(path string) string {
+	if path == "" {
+		return "result"
+	}
+	return path
+}
+
+// joinHosts returns a comma separated list of the host URLs
+func joinHosts(hosts []Host) string {
+	urls := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		urls = append(urls, host.GetHostURL())
+	}
+	return strings.Join(urls, ", ")
+}
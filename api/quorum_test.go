@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindDifference(t *testing.T) {
+	tests := []struct {
+		a, b       interface{}
+		want       string
+		difference bool
+	}{
+		{"a", "a", "", false},
+		{1.0, 2.0, "result: 1 != 2", true},
+		{nil, nil, "", false},
+		{map[string]interface{}{"a": 1.0}, map[string]interface{}{"a": 1.0}, "", false},
+		{map[string]interface{}{"a": 1.0, "b": "x"}, map[string]interface{}{"a": 1.0, "b": "y"}, ".b: x != y", true},
+		{map[string]interface{}{"b": 1.0}, map[string]interface{}{"a": 1.0, "b": 1.0}, ".a: <nil> != 1", true},
+		{map[string]interface{}{"a": 1.0, "c": 1.0}, map[string]interface{}{"a": 2.0, "c": 2.0}, ".a: 1 != 2", true},
+		{[]interface{}{1.0, 2.0}, []interface{}{1.0, 2.0}, "", false},
+		{[]interface{}{1.0}, []interface{}{1.0, 2.0}, "result: 1 items != 2 items", true},
+		{[]interface{}{1.0, 2.0}, []interface{}{1.0, 3.0}, "[1]: 2 != 3", true},
+		{
+			map[string]interface{}{"data": []interface{}{map[string]interface{}{"balance": "1"}}},
+			map[string]interface{}{"data": []interface{}{map[string]interface{}{"balance": "2"}}},
+			".data[0].balance: 1 != 2", true,
+		},
+		{
+			map[string]interface{}{"data": []interface{}{1.0}},
+			map[string]interface{}{"data": []interface{}{}},
+			".data: 1 items != 0 items", true,
+		},
+		{map[string]interface{}{"a": 1.0}, []interface{}{1.0}, "result: map[a:1] != [1]", true},
+	}
+
+	for i, test := range tests {
+		difference, ok := findDifference("", test.a, test.b)
+		if difference != test.want || ok != test.difference {
+			t.Errorf("#%d: findDifference(%v, %v)=%q,%v; want %q,%v", i, test.a, test.b, difference, ok, test.want,
+				test.difference)
+		}
+	}
+}
+
+func TestDescribeDifference(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{`[{"id":"1","amount":"5"}]`, `[{"id":"1","amount":"5"}]`, "none"},
+		{`[{"id":"1","amount":"5"}]`, `[{"id":"1","amount":"6"}]`, "[0].amount: 5 != 6"},
+		{`[{"id":"1"}]`, `[]`, "result: 1 items != 0 items"},
+		{`[`, `[]`, "unknown"},
+	}
+
+	for _, test := range tests {
+		if got := describeDifference(test.a, test.b); got != test.want {
+			t.Errorf("describeDifference(%s, %s)=%q; want %q", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+// newTestQuorumServer returns a node stand-in at the given height that reports the given balance for all accounts
+func newTestQuorumServer(t *testing.T, height int, broadhash string, balance int64) *httptest.Server {
+	return newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": testData(&NodeStatus{Loaded: true, Height: height, Broadhash: broadhash}),
+		"/api/accounts":    testData([]*Account{{Address: "1L", Balance: balance}}),
+	})
+}
+
+func TestQuorumClient_GetAccounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []int64
+		heights  []int
+		want     int64
+		groups   int
+	}{
+		{name: "agreeing hosts", balances: []int64{5, 5, 5}, heights: []int{10, 10, 10}, want: 5},
+		{name: "outvoted host", balances: []int64{5, 6, 5}, heights: []int{10, 10, 10}, want: 5},
+		{name: "host behind", balances: []int64{5, 6, 5}, heights: []int{10, 8, 10}, want: 5},
+		{name: "no quorum", balances: []int64{5, 6, 7}, heights: []int{10, 10, 10}, groups: 3},
+	}
+
+	for _, test := range tests {
+		var servers []*httptest.Server
+		for i := range test.balances {
+			servers = append(servers, newTestQuorumServer(t, test.heights[i], "a", test.balances[i]))
+		}
+
+		res, err := testClient(servers...).Quorum(3, 2).GetAccounts(context.Background(), nil)
+
+		if test.groups == 0 {
+			if err != nil || len(res.Accounts) != 1 || res.Accounts[0].Balance != test.want {
+				t.Errorf("%s: QuorumClient.GetAccounts() returns %v,%v; want balance %d", test.name, res, err,
+					test.want)
+			}
+		} else {
+			quorumErr, ok := err.(*QuorumError)
+			if !ok || len(quorumErr.Groups) != test.groups {
+				t.Errorf("%s: QuorumClient.GetAccounts() returns %v,%v; want QuorumError", test.name, res, err)
+			} else if quorumErr.Differences[0] != "none" ||
+				!strings.HasPrefix(quorumErr.Differences[1], "[0].balance: ") {
+				t.Errorf("%s: QuorumError has differences %v; want balance differences", test.name,
+					quorumErr.Differences)
+			}
+		}
+
+		for _, server := range servers {
+			server.Close()
+		}
+	}
+}
+
+func TestQuorumClient_SkipsForkedHosts(t *testing.T) {
+	first := newTestQuorumServer(t, 10, "a", 5)
+	defer first.Close()
+	forked := newTestQuorumServer(t, 11, "b", 6)
+	defer forked.Close()
+	second := newTestQuorumServer(t, 10, "a", 5)
+	defer second.Close()
+
+	client := testClient(first, forked, second)
+
+	hosts, err := client.Quorum(3, 2).selectHosts(context.Background())
+	if err != nil || len(hosts) != 2 {
+		t.Fatalf("QuorumClient.selectHosts() returns %v,%v; want the hosts of the main fork", hosts, err)
+	}
+	for _, host := range hosts {
+		if host == testHost(forked) {
+			t.Errorf("QuorumClient.selectHosts() returns forked host %v", host)
+		}
+	}
+
+	if hosts, err := client.Quorum(3, 3).selectHosts(context.Background()); err == nil {
+		t.Errorf("QuorumClient.selectHosts() returns %v,nil with 2 hosts in sync; want error", hosts)
+	}
+}
@@ -0,0 +1,439 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"golang.org/x/net/websocket"
+)
+
+type (
+	// SocketClient receives the events of a Lisk node from its socket.io interface.
+	// It reconnects automatically when the connection is lost and keeps its subscriptions.
+	SocketClient struct {
+		client *Client
+		// Events are the names of the events which are received. All supported events are received if it's empty.
+		Events []string
+	}
+
+	// SocketEvent is an event sent by a Lisk node. Only the field belonging to the event is set.
+	SocketEvent struct {
+		// Name of the event
+		Name string
+		// Block is set for blocks/change events
+		Block *BlockChange
+		// Transaction is set for transactions/change events
+		Transaction *Transaction
+		// Round is set for rounds/change events
+		Round *RoundChange
+	}
+
+	// BlockChange is a new block which was applied by the node
+	BlockChange struct {
+		// Block is the new block
+		Block *Block
+		// Transactions are the transactions of the block
+		Transactions []*Transaction
+	}
+
+	// RoundChange is the start of a new round
+	RoundChange struct {
+		// Number of the new round
+		Number int `json:"number"`
+	}
+
+	// engineIOHandshake is the payload of the open packet of an engine.io connection
+	engineIOHandshake struct {
+		SID          string `json:"sid"`
+		PingInterval int    `json:"pingInterval"`
+		PingTimeout  int    `json:"pingTimeout"`
+	}
+
+	// socketBlock is a block in the format which is sent over the socket
+	socketBlock struct {
+		ID                   string            `json:"id"`
+		Version              int               `json:"version"`
+		Timestamp            int               `json:"timestamp"`
+		Height               int               `json:"height"`
+		PreviousBlock        string            `json:"previousBlock"`
+		NumberOfTransactions int               `json:"numberOfTransactions"`
+		TotalAmount          string            `json:"totalAmount"`
+		TotalFee             string            `json:"totalFee"`
+		Reward               string            `json:"reward"`
+		GeneratorPublicKey   string            `json:"generatorPublicKey"`
+		BlockSignature       string            `json:"blockSignature"`
+		Transactions         []json.RawMessage `json:"transactions"`
+	}
+)
+
+const (
+	// SocketEventBlockChange is sent when the node applied a new block
+	SocketEventBlockChange = "blocks/change"
+	// SocketEventTransactionChange is sent when the node received a new unconfirmed transaction
+	SocketEventTransactionChange = "transactions/change"
+	// SocketEventRoundChange is sent when a new round starts
+	SocketEventRoundChange = "rounds/change"
+
+	// socketPath is the path of the socket.io endpoint of the node
+	socketPath = "/socket.io/?EIO=3&transport=websocket"
+
+	// engine.io packet types
+	engineIOOpen    = '0'
+	engineIOClose   = '1'
+	engineIOPing    = '2'
+	engineIOPong    = '3'
+	engineIOMessage = '4'
+
+	// socket.io packet types
+	socketIODisconnect = '1'
+	socketIOEvent      = '2'
+	socketIOError      = '4'
+)
+
+var (
+	errSocketClosed = errors.New("socket was closed by the node")
+)
+
+// NewSocketClient returns a socket client which connects to the current host of the client
+// and receives all supported events
+func (c *Client) NewSocketClient() *SocketClient {
+	return &SocketClient{client: c}
+}
+
+// Listen connects to the node and sends the received events on the returned channel until the context is canceled.
+// Lost connections are reestablished after a backoff. If the host cannot be reached another host of the pool
+// of the client is used.
+func (s *SocketClient) Listen(ctx context.Context) <-chan *SocketEvent {
+	events := make(chan *SocketEvent)
+
+	go func() {
+		defer close(events)
+
+		for retry := 1; ; retry++ {
+			host := s.client.Host()
+
+			connected, _ := s.listen(ctx, host, events)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if connected {
+				retry = 1
+			} else {
+				s.client.pool.markFailed(host)
+				s.client.failover(host)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.client.config.getRetryBackoff(retry)):
+			}
+		}
+	}()
+
+	return events
+}
+
+// listen receives the events of the host until the connection is lost.
+// It returns whether the connection was established.
+func (s *SocketClient) listen(ctx context.Context, host Host, events chan<- *SocketEvent) (bool, error) {
+	config, err := websocket.NewConfig(getSocketURL(host), host.GetHostURL())
+	if err != nil {
+		return false, err
+	}
+	if s.client.config.Timeout > 0 {
+		config.Dialer = &net.Dialer{Timeout: s.client.config.Timeout}
+	}
+
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	// Close the connection when the context is canceled to stop receiving
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	handshake, err := readHandshake(conn)
+	if err != nil {
+		return false, err
+	}
+	s.client.pool.markHealthy(host)
+
+	pingInterval := time.Duration(handshake.PingInterval) * time.Millisecond
+	pingTimeout := time.Duration(handshake.PingTimeout) * time.Millisecond
+
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := websocket.Message.Send(conn, string(engineIOPing)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(pingInterval + pingTimeout))
+
+		var packet string
+		if err := websocket.Message.Receive(conn, &packet); err != nil {
+			return true, err
+		}
+
+		if err := s.handlePacket(ctx, conn, packet, events); err != nil {
+			return true, err
+		}
+	}
+}
+
+// handlePacket handles an engine.io packet and sends the contained event
+func (s *SocketClient) handlePacket(ctx context.Context, conn *websocket.Conn, packet string,
+	events chan<- *SocketEvent) error {
+	if packet == "" {
+		return nil
+	}
+
+	switch packet[0] {
+	case engineIOClose:
+		return errSocketClosed
+	case engineIOPing:
+		return websocket.Message.Send(conn, string(engineIOPong)+packet[1:])
+	case engineIOMessage:
+	default:
+		return nil
+	}
+
+	message := packet[1:]
+	if message == "" {
+		return nil
+	}
+
+	switch message[0] {
+	case socketIODisconnect:
+		return errSocketClosed
+	case socketIOError:
+		return fmt.Errorf("socket error: %s", message[1:])
+	case socketIOEvent:
+	default:
+		return nil
+	}
+
+	name, data, err := parseSocketEvent(message[1:])
+	if err != nil {
+		return err
+	}
+	if !s.isSubscribed(name) {
+		return nil
+	}
+
+	event, err := decodeSocketEvent(name, data)
+	if err != nil || event == nil {
+		// Skip events which can't be decoded
+		return nil
+	}
+
+	select {
+	case events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isSubscribed returns whether the event should be received
+func (s *SocketClient) isSubscribed(name string) bool {
+	return len(s.Events) == 0 || containsString(s.Events, name)
+}
+
+// getSocketURL returns the URL of the socket.io endpoint of the host
+func getSocketURL(host Host) string {
+	if host.Secure {
+		return strings.Replace(host.GetHostURL(), "https", "wss", 1) + socketPath
+	}
+	return strings.Replace(host.GetHostURL(), "http", "ws", 1) + socketPath
+}
+
+// readHandshake reads the open packet of the engine.io connection
+func readHandshake(conn *websocket.Conn) (*engineIOHandshake, error) {
+	var packet string
+	if err := websocket.Message.Receive(conn, &packet); err != nil {
+		return nil, err
+	}
+
+	if packet == "" || packet[0] != engineIOOpen {
+		return nil, errors.New("socket handshake expected")
+	}
+
+	handshake := &engineIOHandshake{}
+	if err := json.Unmarshal([]byte(packet[1:]), handshake); err != nil {
+		return nil, fmt.Errorf("invalid socket handshake: %v", err)
+	}
+
+	if handshake.PingInterval <= 0 || handshake.PingTimeout <= 0 {
+		return nil, errors.New("invalid socket handshake: missing ping interval")
+	}
+
+	return handshake, nil
+}
+
+// parseSocketEvent returns the name and data of a socket.io event packet without the packet type
+func parseSocketEvent(packet string) (string, json.RawMessage, error) {
+	// Skip the namespace
+	if strings.HasPrefix(packet, "/") {
+		separator := strings.Index(packet, ",")
+		if separator < 0 {
+			return "", nil, errors.New("invalid socket event")
+		}
+		packet = packet[separator+1:]
+	}
+
+	// Skip the acknowledgement id
+	packet = strings.TrimLeft(packet, "0123456789")
+
+	var args []json.RawMessage
+	if err := json.Unmarshal([]byte(packet), &args); err != nil || len(args) == 0 {
+		return "", nil, errors.New("invalid socket event")
+	}
+
+	var name string
+	if err := json.Unmarshal(args[0], &name); err != nil {
+		return "", nil, errors.New("invalid socket event name")
+	}
+
+	if len(args) < 2 {
+		return name, nil, nil
+	}
+	return name, args[1], nil
+}
+
+// decodeSocketEvent decodes the data of the event. It returns nil for unsupported events.
+func decodeSocketEvent(name string, data json.RawMessage) (*SocketEvent, error) {
+	event := &SocketEvent{Name: name}
+
+	switch name {
+	case SocketEventBlockChange:
+		block, err := decodeSocketBlock(data)
+		if err != nil {
+			return nil, err
+		}
+		event.Block = block
+	case SocketEventTransactionChange:
+		transaction, err := decodeSocketTransaction(data)
+		if err != nil {
+			return nil, err
+		}
+		event.Transaction = transaction
+	case SocketEventRoundChange:
+		round := &RoundChange{}
+		if err := json.Unmarshal(data, round); err != nil {
+			return nil, err
+		}
+		event.Round = round
+	default:
+		return nil, nil
+	}
+
+	return event, nil
+}
+
+// decodeSocketBlock decodes a block sent over the socket
+func decodeSocketBlock(data json.RawMessage) (*BlockChange, error) {
+	data, err := quoteNumbers(data, "totalAmount", "totalFee", "reward")
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &socketBlock{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+
+	block := &Block{
+		ID:                   payload.ID,
+		Version:              payload.Version,
+		Height:               payload.Height,
+		Timestamp:            payload.Timestamp,
+		GeneratorPublicKey:   payload.GeneratorPublicKey,
+		BlockSignature:       payload.BlockSignature,
+		PreviousBlockID:      payload.PreviousBlock,
+		NumberOfTransactions: payload.NumberOfTransactions,
+		TotalAmount:          payload.TotalAmount,
+		TotalFee:             payload.TotalFee,
+		Reward:               payload.Reward,
+	}
+
+	if generatorPublicKey, err := hex.DecodeString(payload.GeneratorPublicKey); err == nil {
+		block.GeneratorAddress = crypto.GetAddressFromPublicKey(generatorPublicKey)
+	}
+
+	change := &BlockChange{Block: block}
+	for _, transactionData := range payload.Transactions {
+		transaction, err := decodeSocketTransaction(transactionData)
+		if err != nil {
+			return nil, err
+		}
+		transaction.BlockID = block.ID
+		transaction.Height = block.Height
+
+		change.Transactions = append(change.Transactions, transaction)
+	}
+
+	return change, nil
+}
+
+// decodeSocketTransaction decodes a transaction sent over the socket
+func decodeSocketTransaction(data json.RawMessage) (*Transaction, error) {
+	data, err := quoteNumbers(data, "amount", "fee")
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &Transaction{}
+	if err := json.Unmarshal(data, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// quoteNumbers converts the given numeric fields of the JSON object to strings.
+// Nodes send amounts as numbers over the socket but as strings over the HTTP API.
+func quoteNumbers(data json.RawMessage, keys ...string) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		value, ok := fields[key]
+		if !ok || len(value) == 0 || value[0] == '"' || string(value) == "null" {
+			continue
+		}
+		fields[key] = json.RawMessage(`"` + string(value) + `"`)
+	}
+
+	return json.Marshal(fields)
+}
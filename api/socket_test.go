@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/transactions"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	testSocketHandshake = `0{"sid":"abc","upgrades":[],"pingInterval":25000,"pingTimeout":60000}`
+	testSocketBlock     = `42["blocks/change",{"id":"1462190441827192029","version":1,"timestamp":141738,"height":10,` +
+		`"previousBlock":"10620616195853047363","numberOfTransactions":1,"totalAmount":100000000,"totalFee":10000000,` +
+		`"reward":500000000,"generatorPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09",` +
+		`"transactions":[{"id":"13987348420913138422","type":0,"amount":100000000,"fee":10000000,"timestamp":141700,` +
+		`"senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09",` +
+		`"recipientId":"104666L","asset":{}}]}]`
+	testSocketTransaction = `42["transactions/change",{"id":"13987348420913138423","type":0,"amount":"5","fee":"10000000",` +
+		`"timestamp":141739,"recipientId":"104666L","asset":{"data":"abc"}}]`
+	testSocketRound = `42/,7["rounds/change",{"number":12}]`
+)
+
+// newTestSocketServer returns a socket.io stand-in which sends the given packets on each connection.
+// The first connection is closed after the packets were sent.
+func newTestSocketServer(t *testing.T, connections ...[]string) *httptest.Server {
+	connection := make(chan []string, len(connections))
+	for _, packets := range connections {
+		connection <- packets
+	}
+
+	return httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		defer conn.Close()
+
+		var packets []string
+		select {
+		case packets = <-connection:
+		default:
+			return
+		}
+
+		if err := websocket.Message.Send(conn, testSocketHandshake); err != nil {
+			t.Errorf("cannot send handshake: %v", err)
+			return
+		}
+		websocket.Message.Send(conn, "40")
+
+		for _, packet := range packets {
+			if err := websocket.Message.Send(conn, packet); err != nil {
+				t.Errorf("cannot send packet: %v", err)
+				return
+			}
+		}
+
+		if len(connection) == 0 {
+			// Keep the last connection open until the client disconnects
+			var packet string
+			for websocket.Message.Receive(conn, &packet) == nil {
+			}
+		}
+	}))
+}
+
+func testSocketClient(t *testing.T, server *httptest.Server) *Client {
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+
+	return NewClientWithCustomConfig(&Config{
+		Host:         Host{Hostname: serverURL.Hostname(), Port: port},
+		RetryBackoff: 10 * time.Millisecond,
+	})
+}
+
+func receiveSocketEvent(t *testing.T, events <-chan *SocketEvent) *SocketEvent {
+	select {
+	case event := <-events:
+		if event == nil {
+			t.Fatalf("SocketClient.Listen() closed the channel; expected event")
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("SocketClient.Listen() returns no event")
+	}
+	return nil
+}
+
+func TestSocketClient_Listen(t *testing.T) {
+	server := newTestSocketServer(t, []string{testSocketBlock, "42[\"unknown\",{}]", testSocketTransaction, "1"},
+		[]string{testSocketRound})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := testSocketClient(t, server).NewSocketClient().Listen(ctx)
+
+	event := receiveSocketEvent(t, events)
+	if event.Name != SocketEventBlockChange || event.Block == nil {
+		t.Fatalf("SocketClient.Listen() returns wrong event: %v; want %s", event, SocketEventBlockChange)
+	}
+	block := event.Block.Block
+	if block.ID != "1462190441827192029" || block.Height != 10 || block.PreviousBlockID != "10620616195853047363" ||
+		block.TotalAmount != "100000000" || block.GeneratorAddress != "18160565574430594874L" {
+		t.Errorf("SocketClient.Listen() returns wrong block: %v", block)
+	}
+	if len(event.Block.Transactions) != 1 || event.Block.Transactions[0].Amount != "100000000" ||
+		event.Block.Transactions[0].BlockID != block.ID {
+		t.Errorf("SocketClient.Listen() returns wrong block transactions: %v", event.Block.Transactions)
+	}
+
+	event = receiveSocketEvent(t, events)
+	if event.Name != SocketEventTransactionChange || event.Transaction == nil {
+		t.Fatalf("SocketClient.Listen() returns wrong event: %v; want %s", event, SocketEventTransactionChange)
+	}
+	if asset, ok := event.Transaction.Asset.(transactions.DataAsset); !ok || string(asset) != "abc" ||
+		event.Transaction.Amount != "5" {
+		t.Errorf("SocketClient.Listen() returns wrong transaction: %v", event.Transaction)
+	}
+
+	// The node closed the connection, so the round event is received after reconnecting
+	event = receiveSocketEvent(t, events)
+	if event.Name != SocketEventRoundChange || event.Round == nil || event.Round.Number != 12 {
+		t.Errorf("SocketClient.Listen() returns wrong event: %v; want round 12", event)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("SocketClient.Listen() returns event after cancel; expected closed channel")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("SocketClient.Listen() does not close the channel after cancel")
+	}
+}
+
+func TestSocketClient_ListenSubscribed(t *testing.T) {
+	server := newTestSocketServer(t, []string{testSocketBlock, testSocketTransaction, testSocketRound})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	socket := testSocketClient(t, server).NewSocketClient()
+	socket.Events = []string{SocketEventRoundChange}
+
+	if event := receiveSocketEvent(t, socket.Listen(ctx)); event.Name != SocketEventRoundChange {
+		t.Errorf("SocketClient.Listen() returns event %s; want only %s", event.Name, SocketEventRoundChange)
+	}
+}
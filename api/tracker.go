@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/liskascend/lisk-go/network"
+)
+
+type (
+	// TransactionStatus is the status of a transaction on its way into the blockchain
+	TransactionStatus string
+
+	// TransactionStatusUpdate is the status of a tracked transaction
+	TransactionStatusUpdate struct {
+		// ID of the transaction
+		ID string
+		// Status of the transaction
+		Status TransactionStatus
+		// Confirmations of the transaction if it's confirmed
+		Confirmations int
+		// Transaction is the transaction as returned by the node. It's nil if the transaction is unknown.
+		Transaction *Transaction
+	}
+
+	// TransactionTracker follows transactions through the transaction pool into the blockchain
+	TransactionTracker struct {
+		client *Client
+		// PollInterval is the interval in which the status of a transaction is requested
+		PollInterval time.Duration
+		// DropThreshold is the number of consecutive polls a transaction that was seen before has to be missing
+		// until it's reported as dropped
+		DropThreshold int
+		// Expiry is the time after the timestamp of a transaction after which it's reported as expired if it was
+		// not confirmed yet
+		Expiry time.Duration
+	}
+)
+
+const (
+	// TransactionStatusUnknown is the status of a transaction that is neither in the pool nor in the blockchain
+	TransactionStatusUnknown TransactionStatus = "unknown"
+	// TransactionStatusUnprocessed is the status of a transaction that was not processed yet
+	TransactionStatusUnprocessed TransactionStatus = "unprocessed"
+	// TransactionStatusUnconfirmed is the status of a transaction that waits to be included in a block
+	TransactionStatusUnconfirmed TransactionStatus = "unconfirmed"
+	// TransactionStatusUnsigned is the status of a multisignature transaction that is missing signatures
+	TransactionStatusUnsigned TransactionStatus = "unsigned"
+	// TransactionStatusConfirmed is the status of a transaction that is included in a block
+	TransactionStatusConfirmed TransactionStatus = "confirmed"
+	// TransactionStatusDropped is the status of a transaction that disappeared from the pool or blockchain
+	TransactionStatusDropped TransactionStatus = "dropped"
+	// TransactionStatusExpired is the status of a transaction that was not confirmed within the expiry time
+	TransactionStatusExpired TransactionStatus = "expired"
+
+	// DefaultTrackerPollInterval is the default interval in which the status of a transaction is requested
+	DefaultTrackerPollInterval = 10 * time.Second
+	// DefaultTrackerDropThreshold is the default number of polls a transaction has to be missing to be dropped
+	DefaultTrackerDropThreshold = 3
+	// DefaultTrackerExpiry is the default expiry time of transactions which equals the time unconfirmed
+	// transactions are kept in the pool of Lisk Core
+	DefaultTrackerExpiry = 3 * time.Hour
+)
+
+var (
+	// ErrTransactionDropped is returned when a transaction was dropped from the pool or blockchain
+	ErrTransactionDropped = errors.New("transaction was dropped")
+	// ErrTransactionExpired is returned when a transaction was not confirmed within the expiry time
+	ErrTransactionExpired = errors.New("transaction expired")
+)
+
+// NewTransactionTracker returns a tracker with the default settings
+func (c *Client) NewTransactionTracker() *TransactionTracker {
+	return &TransactionTracker{
+		client:        c,
+		PollInterval:  DefaultTrackerPollInterval,
+		DropThreshold: DefaultTrackerDropThreshold,
+		Expiry:        DefaultTrackerExpiry,
+	}
+}
+
+// GetTransactionStatus returns the current status of the transaction with the given ID.
+// The transaction pool is checked before the blockchain so a transaction that is included in a block in the
+// meantime is not reported as unknown.
+func (c *Client) GetTransactionStatus(ctx context.Context, id string) (*TransactionStatusUpdate, error) {
+	states := []TransactionState{TransactionStateUnprocessed, TransactionStateUnconfirmed, TransactionStateUnsigned}
+
+	pending := &TransactionStatusUpdate{ID: id, Status: TransactionStatusUnknown}
+	for _, state := range states {
+		res, err := c.GetPendingTransactions(ctx, state, &QueueRequest{ID: id})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(res.Transactions) > 0 {
+			pending.Status = TransactionStatus(state)
+			pending.Transaction = res.Transactions[0]
+			break
+		}
+	}
+
+	res, err := c.GetTransactions(ctx, &TransactionRequest{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Transactions) > 0 {
+		return &TransactionStatusUpdate{
+			ID:            id,
+			Status:        TransactionStatusConfirmed,
+			Confirmations: res.Transactions[0].Confirmations,
+			Transaction:   res.Transactions[0],
+		}, nil
+	}
+
+	return pending, nil
+}
+
+// Track follows the transaction with the given ID and reports every change of its status or confirmations.
+// Tracking stops and the channel is closed when the context is canceled or the transaction is dropped or expired.
+// Requests that fail are retried with the next poll.
+func (t *TransactionTracker) Track(ctx context.Context, id string) <-chan *TransactionStatusUpdate {
+	updates := make(chan *TransactionStatusUpdate)
+
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(t.getPollInterval())
+		defer ticker.Stop()
+
+		// The deadline is only known once the transaction was seen, until then the tracking start is used
+		expiry := time.Now().Add(t.getExpiry())
+		expirySet := false
+
+		var last *TransactionStatusUpdate
+		seen := false
+		missing := 0
+
+		for {
+			status, err := t.client.GetTransactionStatus(ctx, id)
+			if err == nil {
+				switch status.Status {
+				case TransactionStatusUnknown:
+					missing++
+					if seen && missing >= t.getDropThreshold() {
+						status.Status = TransactionStatusDropped
+					} else if seen {
+						// Keep the last status until the transaction is missing for long enough
+						status = last
+					}
+				default:
+					seen = true
+					missing = 0
+
+					if !expirySet {
+						if deadline, ok := t.expiryOf(status.Transaction); ok {
+							expiry = deadline
+							expirySet = true
+						}
+					}
+				}
+
+				if status.Status != TransactionStatusConfirmed && status.Status != TransactionStatusDropped &&
+					time.Now().After(expiry) {
+					status = &TransactionStatusUpdate{ID: id, Status: TransactionStatusExpired}
+				}
+
+				if last == nil || status.Status != last.Status || status.Confirmations != last.Confirmations {
+					select {
+					case updates <- status:
+					case <-ctx.Done():
+						return
+					}
+				}
+				last = status
+
+				if status.Status == TransactionStatusDropped || status.Status == TransactionStatusExpired {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return updates
+}
+
+// WaitForConfirmations blocks until the transaction with the given ID has at least n confirmations.
+// ErrTransactionDropped or ErrTransactionExpired is returned if the transaction won't be confirmed anymore,
+// so it can be signed and sent again.
+func (t *TransactionTracker) WaitForConfirmations(ctx context.Context, id string, n int) (
+	*TransactionStatusUpdate, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for status := range t.Track(ctx, id) {
+		switch status.Status {
+		case TransactionStatusConfirmed:
+			if status.Confirmations >= n {
+				return status, nil
+			}
+		case TransactionStatusDropped:
+			return status, ErrTransactionDropped
+		case TransactionStatusExpired:
+			return status, ErrTransactionExpired
+		}
+	}
+
+	return nil, ctx.Err()
+}
+
+// WaitForConfirmations blocks until the transaction with the given ID has at least n confirmations
+// using a tracker with the default settings. See TransactionTracker.WaitForConfirmations.
+func (c *Client) WaitForConfirmations(ctx context.Context, id string, n int) (*TransactionStatusUpdate, error) {
+	return c.NewTransactionTracker().WaitForConfirmations(ctx, id, n)
+}
+
+// expiryOf returns the time at which the transaction expires based on its timestamp or the time the node
+// received it
+func (t *TransactionTracker) expiryOf(transaction *Transaction) (time.Time, bool) {
+	if transaction == nil {
+		return time.Time{}, false
+	}
+
+	if transaction.Timestamp > 0 {
+		net := t.client.Network()
+		if net == nil {
+			net = network.Mainnet
+		}
+		return net.GetTime(uint32(transaction.Timestamp)).Add(t.getExpiry()), true
+	}
+	if !transaction.ReceivedAt.IsZero() {
+		return transaction.ReceivedAt.Add(t.getExpiry()), true
+	}
+
+	return time.Time{}, false
+}
+
+// getPollInterval returns the poll interval
+func (t *TransactionTracker) getPollInterval() time.Duration {
+	if t.PollInterval <= 0 {
+		return DefaultTrackerPollInterval
+	}
+	return t.PollInterval
+}
+
+// getDropThreshold returns the number of polls a transaction has to be missing to be dropped
+func (t *TransactionTracker) getDropThreshold() int {
+	if t.DropThreshold <= 0 {
+		return DefaultTrackerDropThreshold
+	}
+	return t.DropThreshold
+}
+
+// getExpiry returns the expiry time of transactions
+func (t *TransactionTracker) getExpiry() time.Duration {
+	if t.Expiry <= 0 {
+		return DefaultTrackerExpiry
+	}
+	return t.Expiry
+}
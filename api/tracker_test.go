@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/network"
+)
+
+// testTrackedState is the state of the tracked transaction at a poll.
+// State is a pool state, "confirmed" or empty if the transaction is unknown.
+type testTrackedState struct {
+	state         string
+	confirmations int
+}
+
+// newTestTrackerServer returns a node stand-in that reports the given state of the transaction at each poll.
+// The last state is kept after all states were reported.
+func newTestTrackerServer(t *testing.T, transaction *Transaction, states []testTrackedState) *httptest.Server {
+	var mu sync.Mutex
+	poll := 0
+
+	current := func() testTrackedState {
+		if poll >= len(states) {
+			return states[len(states)-1]
+		}
+		return states[poll]
+	}
+
+	pool := func(r *http.Request) (interface{}, int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if "/api/node/transactions/"+current().state != r.URL.Path {
+			return []*Transaction{}, http.StatusOK
+		}
+		return []*Transaction{transaction}, http.StatusOK
+	}
+
+	return newTestAPIServer(t, testAPIRoutes{
+		"/api/node/transactions/unprocessed": pool,
+		"/api/node/transactions/unconfirmed": pool,
+		"/api/node/transactions/unsigned":    pool,
+		"/api/transactions": func(r *http.Request) (interface{}, int) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			// The blockchain is requested last, so the next request belongs to the next poll
+			state := current()
+			poll++
+
+			if state.state != string(TransactionStatusConfirmed) {
+				return []*Transaction{}, http.StatusOK
+			}
+			confirmed := *transaction
+			confirmed.Confirmations = state.confirmations
+			return []*Transaction{&confirmed}, http.StatusOK
+		},
+	})
+}
+
+func TestTransactionTracker_WaitForConfirmations(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		timestamp     time.Time
+		receivedAt    time.Time
+		states        []testTrackedState
+		status        TransactionStatus
+		confirmations int
+		err           error
+	}{
+		{
+			name:      "confirmed",
+			timestamp: now,
+			states: []testTrackedState{
+				{state: "unprocessed"}, {state: "unconfirmed"}, {state: "confirmed", confirmations: 1},
+				{state: "confirmed", confirmations: 2},
+			},
+			status:        TransactionStatusConfirmed,
+			confirmations: 2,
+		},
+		{
+			name:          "missing shorter than the drop threshold",
+			timestamp:     now,
+			states:        []testTrackedState{{state: "unconfirmed"}, {}, {state: "confirmed", confirmations: 2}},
+			status:        TransactionStatusConfirmed,
+			confirmations: 2,
+		},
+		{
+			name:      "dropped",
+			timestamp: now,
+			states:    []testTrackedState{{state: "unconfirmed"}, {}, {}},
+			status:    TransactionStatusDropped,
+			err:       ErrTransactionDropped,
+		},
+		{
+			name:          "unknown before it is sent",
+			timestamp:     now,
+			states:        []testTrackedState{{}, {}, {}, {state: "confirmed", confirmations: 2}},
+			status:        TransactionStatusConfirmed,
+			confirmations: 2,
+		},
+		{
+			name:      "expired by timestamp",
+			timestamp: now.Add(-2 * time.Hour),
+			states:    []testTrackedState{{state: "unconfirmed"}},
+			status:    TransactionStatusExpired,
+			err:       ErrTransactionExpired,
+		},
+		{
+			name:       "expired by receivedAt",
+			receivedAt: now.Add(-2 * time.Hour),
+			states:     []testTrackedState{{state: "unsigned"}},
+			status:     TransactionStatusExpired,
+			err:        ErrTransactionExpired,
+		},
+		{
+			name:          "confirmed after expiry",
+			timestamp:     now.Add(-2 * time.Hour),
+			states:        []testTrackedState{{state: "confirmed", confirmations: 2}},
+			status:        TransactionStatusConfirmed,
+			confirmations: 2,
+		},
+	}
+
+	for _, test := range tests {
+		transaction := &Transaction{ID: "1", ReceivedAt: test.receivedAt}
+		if !test.timestamp.IsZero() {
+			transaction.Timestamp = int(network.Mainnet.GetTimestamp(test.timestamp))
+		}
+
+		server := newTestTrackerServer(t, transaction, test.states)
+
+		tracker := testClient(server).NewTransactionTracker()
+		tracker.PollInterval = time.Millisecond
+		tracker.DropThreshold = 2
+		tracker.Expiry = time.Hour
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		status, err := tracker.WaitForConfirmations(ctx, "1", 2)
+		cancel()
+
+		if err != test.err || status == nil || status.Status != test.status ||
+			status.Confirmations != test.confirmations {
+			t.Errorf("%s: TransactionTracker.WaitForConfirmations() returns %+v,%v; want status %s with %d "+
+				"confirmations and error %v", test.name, status, err, test.status, test.confirmations, test.err)
+		}
+
+		server.Close()
+	}
+}
+
+func TestTransactionTracker_Track(t *testing.T) {
+	server := newTestTrackerServer(t, &Transaction{ID: "1", Timestamp: int(network.Mainnet.GetTimestamp(time.Now()))},
+		[]testTrackedState{
+			{state: "unconfirmed"}, {state: "unconfirmed"}, {}, {state: "confirmed", confirmations: 1},
+			{state: "confirmed", confirmations: 1}, {}, {}, {},
+		})
+	defer server.Close()
+
+	tracker := testClient(server).NewTransactionTracker()
+	tracker.PollInterval = time.Millisecond
+	tracker.DropThreshold = 3
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Only changes are reported and the transaction is dropped after 3 missing polls
+	var updates []string
+	for status := range tracker.Track(ctx, "1") {
+		updates = append(updates, string(status.Status))
+	}
+
+	if got := strings.Join(updates, " "); got != "unconfirmed confirmed dropped" {
+		t.Errorf("TransactionTracker.Track() reports %q; want %q", got, "unconfirmed confirmed dropped")
+	}
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// VotePlan contains the changes required to move the votes of an account to a desired set of delegates
+	VotePlan struct {
+		// Address of the voting account
+		Address string
+		// Votes are the public keys of the delegates to vote for
+		Votes [][]byte
+		// Unvotes are the public keys of the delegates to remove votes from
+		Unvotes [][]byte
+	}
+)
+
+const (
+	// maxVotesPerTransaction is the maximum number of votes and unvotes of a single vote transaction
+	maxVotesPerTransaction = 33
+)
+
+// PlanVotes compares the current votes of the account with the desired delegates and returns the minimal changes.
+// Delegates can be given by username or hex encoded public key. Usernames are resolved using GetDelegate.
+func (c *Client) PlanVotes(ctx context.Context, address string, delegates []string) (*VotePlan, error) {
+	target, err := c.resolveDelegates(ctx, delegates)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(target) > maxAccountVotes {
+		return nil, fmt.Errorf("cannot vote for more than %d delegates", maxAccountVotes)
+	}
+
+	var current []string
+	it := c.IterateVotes(ctx, &VoterRequest{Address: address})
+	for it.Next() {
+		current = append(current, it.Vote().PublicKey)
+	}
+	it.Close()
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	plan := &VotePlan{Address: address}
+
+	for _, publicKey := range current {
+		if !containsString(target, publicKey) {
+			key, err := hex.DecodeString(publicKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid public key %s of current vote: %v", publicKey, err)
+			}
+			plan.Unvotes = append(plan.Unvotes, key)
+		}
+	}
+
+	for _, publicKey := range target {
+		if !containsString(current, publicKey) {
+			key, _ := hex.DecodeString(publicKey)
+			plan.Votes = append(plan.Votes, key)
+		}
+	}
+
+	return plan, nil
+}
+
+// IsEmpty returns whether the votes of the account already match the desired delegates
+func (p *VotePlan) IsEmpty() bool {
+	return len(p.Votes)+len(p.Unvotes) == 0
+}
+
+// Transactions splits the plan into vote transactions with at most 33 changes each and signs them using the
+// given signers. The second signer is optional and only required for lisk wallets with a second signature.
+// The unvotes come first, so the account never exceeds the maximum number of votes when the transactions are
+// sent in the returned order.
+func (p *VotePlan) Transactions(net *network.Network, signer crypto.Signer, secondSigner crypto.Signer,
+	timeOffset int64) ([]*transactions.Transaction, error) {
+	if signer == nil {
+		return nil, errors.New("signer must not be nil")
+	}
+
+	if address := crypto.GetAddressFromPublicKey(signer.PublicKey()); address != p.Address {
+		return nil, fmt.Errorf("signer %s is not the voting account %s", address, p.Address)
+	}
+
+	var result []*transactions.Transaction
+
+	unvotes, votes := p.Unvotes, p.Votes
+	for len(unvotes)+len(votes) > 0 {
+		var batchUnvotes, batchVotes [][]byte
+
+		count := minInt(len(unvotes), maxVotesPerTransaction)
+		batchUnvotes, unvotes = unvotes[:count], unvotes[count:]
+
+		count = minInt(len(votes), maxVotesPerTransaction-len(batchUnvotes))
+		batchVotes, votes = votes[:count], votes[count:]
+
+		transaction, err := transactions.NewVoteTransaction(net, signer, secondSigner, timeOffset,
+			batchVotes, batchUnvotes)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, transaction)
+	}
+
+	return result, nil
+}
+
+// resolveDelegates returns the hex encoded public keys of the delegates given by username or public key
+func (c *Client) resolveDelegates(ctx context.Context, delegates []string) ([]string, error) {
+	var result []string
+
+	for _, delegate := range delegates {
+		var publicKey string
+
+		if key, err := hex.DecodeString(delegate); err == nil && len(key) == ed25519.PublicKeySize {
+			publicKey = hex.EncodeToString(key)
+		} else {
+			res, err := c.GetDelegate(ctx, &DelegateRequest{Username: delegate})
+			if err != nil {
+				return nil, err
+			}
+
+			if res.Delegate == nil || res.Delegate.Account == nil {
+				return nil, fmt.Errorf("delegate %s not found", delegate)
+			}
+			publicKey = res.Delegate.Account.PublicKey
+		}
+
+		if !containsString(result, publicKey) {
+			result = append(result, publicKey)
+		}
+	}
+
+	return result, nil
+}
+
+// minInt returns the smaller of two ints
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
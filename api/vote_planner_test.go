@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+func TestVotePlan_Transactions(t *testing.T) {
+	tests := []struct {
+		name    string
+		votes   int
+		unvotes int
+		// want are the number of unvotes and votes of each transaction
+		want [][2]int
+	}{
+		{name: "votes", votes: 3, want: [][2]int{{0, 3}}},
+		{name: "33 votes", votes: 33, want: [][2]int{{0, 33}}},
+		{name: "34 votes", votes: 34, want: [][2]int{{0, 33}, {0, 1}}},
+		{name: "unvotes first", votes: 20, unvotes: 20, want: [][2]int{{20, 13}, {0, 7}}},
+		{name: "33 unvotes", votes: 1, unvotes: 33, want: [][2]int{{33, 0}, {0, 1}}},
+		{name: "101 changes", votes: 50, unvotes: 51, want: [][2]int{{33, 0}, {18, 15}, {0, 33}, {0, 2}}},
+	}
+
+	for _, test := range tests {
+		plan := &VotePlan{
+			Address: preflightAddress,
+			Votes:   testDelegateKeys(0, test.votes),
+			Unvotes: testDelegateKeys(1000, 1000+test.unvotes),
+		}
+
+		result, err := plan.Transactions(network.Testnet, preflightSigner, nil, 0)
+		if err != nil || len(result) != len(test.want) {
+			t.Errorf("%s: VotePlan.Transactions() returns %d transactions,%v; want %d", test.name, len(result), err,
+				len(test.want))
+			continue
+		}
+
+		var votes, unvotes [][]byte
+		for i, transaction := range result {
+			asset := transaction.Asset.(*transactions.CastVoteAsset)
+			if len(asset.Unvotes) != test.want[i][0] || len(asset.Votes) != test.want[i][1] {
+				t.Errorf("%s: transaction %d has %d unvotes and %d votes; want %d and %d", test.name, i,
+					len(asset.Unvotes), len(asset.Votes), test.want[i][0], test.want[i][1])
+			}
+			if transaction.RecipientID != preflightAddress {
+				t.Errorf("%s: transaction %d is sent to %s; want %s", test.name, i, transaction.RecipientID,
+					preflightAddress)
+			}
+			votes, unvotes = append(votes, asset.Votes...), append(unvotes, asset.Unvotes...)
+		}
+
+		if !equalKeys(votes, plan.Votes) || !equalKeys(unvotes, plan.Unvotes) {
+			t.Errorf("%s: VotePlan.Transactions() does not contain the changes of the plan in order", test.name)
+		}
+	}
+
+	plan := &VotePlan{Address: "1L", Votes: testDelegateKeys(0, 1)}
+	if result, err := plan.Transactions(network.Testnet, preflightSigner, nil, 0); err == nil {
+		t.Errorf("VotePlan.Transactions() returns %v,nil for another account; want error", result)
+	}
+}
+
+func TestClient_PlanVotes(t *testing.T) {
+	delegates := testDelegates(testDelegateKeys(0, 200))
+	server := newTestPreflightServer(t, &testAccountState{votes: testDelegateKeys(0, 3), delegates: delegates})
+	defer server.Close()
+
+	client := testClient(server)
+
+	var target []string
+	for _, key := range testDelegateKeys(1, 5) {
+		target = append(target, hex.EncodeToString(key))
+	}
+
+	plan, err := client.PlanVotes(context.Background(), preflightAddress, append(target, target[0]))
+	if err != nil || !equalKeys(plan.Unvotes, testDelegateKeys(0, 1)) || !equalKeys(plan.Votes, testDelegateKeys(3, 5)) {
+		t.Errorf("Client.PlanVotes() returns %v,%v; want unvote of 0 and votes for 3 and 4", plan, err)
+	}
+
+	if plan, err := client.PlanVotes(context.Background(), preflightAddress, []string{"unknown"}); err == nil {
+		t.Errorf("Client.PlanVotes() returns %v,nil for an unknown username; want error", plan)
+	}
+
+	// The account can vote for at most 101 delegates
+	target = nil
+	for _, key := range testDelegateKeys(0, 102) {
+		target = append(target, hex.EncodeToString(key))
+	}
+
+	if plan, err := client.PlanVotes(context.Background(), preflightAddress, target[:101]); err != nil ||
+		len(plan.Votes) != 98 || len(plan.Unvotes) != 0 {
+		t.Errorf("Client.PlanVotes() returns %v,%v for 101 delegates; want 98 votes", plan, err)
+	}
+
+	if plan, err := client.PlanVotes(context.Background(), preflightAddress, target); err == nil {
+		t.Errorf("Client.PlanVotes() returns %v,nil for 102 delegates; want error", plan)
+	}
+}
+
+// equalKeys returns whether both lists contain the same keys in the same order
+func equalKeys(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
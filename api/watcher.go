@@ -0,0 +1,340 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+type (
+	// BlockEvent is a block that was added to or removed from the chain
+	BlockEvent struct {
+		// Block is the block
+		Block *Block
+		// Transactions are the transactions of the block that match the filter of the watcher
+		Transactions []*Transaction
+		// Rollback specifies whether the block was removed from the chain because of a fork.
+		// Its transactions are not confirmed anymore.
+		Rollback bool
+	}
+
+	// WatchFilter specifies which blocks and transactions are reported by a BlockWatcher.
+	// All criteria that are set have to match.
+	WatchFilter struct {
+		// Address matches transactions sent from or to the address
+		Address string
+		// DelegatePublicKey matches blocks forged by the delegate and transactions sent by the delegate
+		// or voting for or against it
+		DelegatePublicKey string
+		// Type matches transactions of the type
+		Type *int
+	}
+
+	// BlockWatcher follows the head of the chain and reports new blocks and rollbacks
+	BlockWatcher struct {
+		client *Client
+		// Filter specifies which blocks and transactions are reported. All blocks are reported if it's nil.
+		Filter *WatchFilter
+		// PollInterval is the interval in which the chain head is requested
+		PollInterval time.Duration
+		// StartHeight is the height of the first reported block. The watcher starts at the current head if it's 0.
+		StartHeight int
+		// MaxRollback is the maximum number of blocks that are kept to detect rollbacks
+		MaxRollback int
+	}
+
+	// watchedBlock is a block of the chain followed by a watcher
+	watchedBlock struct {
+		block        *Block
+		transactions []*Transaction
+	}
+)
+
+const (
+	// DefaultWatcherPollInterval is the default interval in which the chain head is requested
+	DefaultWatcherPollInterval = 10 * time.Second
+	// DefaultWatcherMaxRollback is the default number of blocks kept to detect rollbacks which is one round
+	DefaultWatcherMaxRollback = 101
+)
+
+// NewBlockWatcher returns a watcher with the default settings that reports the blocks and transactions
+// matching the filter. The filter can be nil to report all blocks.
+func (c *Client) NewBlockWatcher(filter *WatchFilter) *BlockWatcher {
+	return &BlockWatcher{
+		client:       c,
+		Filter:       filter,
+		PollInterval: DefaultWatcherPollInterval,
+		MaxRollback:  DefaultWatcherMaxRollback,
+	}
+}
+
+// Watch follows the chain until the context is canceled and sends the new blocks on the returned channel.
+// When the chain switches to a fork, the removed blocks are sent with Rollback set before the blocks of the fork.
+// Requests that fail are retried with the next poll.
+func (w *BlockWatcher) Watch(ctx context.Context) <-chan *BlockEvent {
+	events := make(chan *BlockEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.getPollInterval())
+		defer ticker.Stop()
+
+		var chain []*watchedBlock
+		for {
+			var err error
+			if chain, err = w.poll(ctx, chain, events); err != nil && ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll synchronizes the chain with the node and sends the changes
+func (w *BlockWatcher) poll(ctx context.Context, chain []*watchedBlock, events chan<- *BlockEvent) (
+	[]*watchedBlock, error) {
+	res, err := w.client.GetNodeStatus(ctx)
+	if err != nil {
+		return chain, err
+	}
+	if res.NodeStatus == nil {
+		return chain, errNoNodeStatus
+	}
+	head := res.NodeStatus.Height
+
+	if len(chain) == 0 {
+		start := w.StartHeight
+		if start <= 0 {
+			// Start at the head without reporting it
+			block, err := w.getBlock(ctx, head)
+			if err != nil || block == nil {
+				return chain, err
+			}
+			return []*watchedBlock{{block: block}}, nil
+		}
+
+		block, err := w.getBlock(ctx, start)
+		if err != nil || block == nil {
+			return chain, err
+		}
+		if chain, err = w.add(ctx, chain, block, events); err != nil {
+			return chain, err
+		}
+	}
+
+	for {
+		tip := chain[len(chain)-1]
+
+		// Blocks above the head were removed from the chain of the node
+		if tip.block.Height > head {
+			if chain, err = w.rollback(ctx, chain, events); err != nil {
+				return chain, err
+			}
+			if len(chain) == 0 {
+				block, err := w.getBlock(ctx, head)
+				if err != nil || block == nil {
+					return chain, err
+				}
+				return w.add(ctx, chain, block, events)
+			}
+			continue
+		}
+
+		// Check whether the tip is still part of the chain of the node
+		block, err := w.getBlock(ctx, tip.block.Height)
+		if err != nil || block == nil {
+			return chain, err
+		}
+
+		if block.ID != tip.block.ID {
+			if chain, err = w.rollback(ctx, chain, events); err != nil {
+				return chain, err
+			}
+			if len(chain) == 0 {
+				return w.add(ctx, chain, block, events)
+			}
+			continue
+		}
+
+		if tip.block.Height == head {
+			return chain, nil
+		}
+
+		next, err := w.getBlock(ctx, tip.block.Height+1)
+		if err != nil || next == nil {
+			return chain, err
+		}
+
+		if next.PreviousBlockID != tip.block.ID {
+			if chain, err = w.rollback(ctx, chain, events); err != nil {
+				return chain, err
+			}
+			if len(chain) == 0 {
+				return w.add(ctx, chain, next, events)
+			}
+			continue
+		}
+
+		if chain, err = w.add(ctx, chain, next, events); err != nil {
+			return chain, err
+		}
+	}
+}
+
+// add appends the block to the chain and reports it
+func (w *BlockWatcher) add(ctx context.Context, chain []*watchedBlock, block *Block, events chan<- *BlockEvent) (
+	[]*watchedBlock, error) {
+	var blockTransactions []*Transaction
+	if block.NumberOfTransactions > 0 {
+		it := w.client.IterateTransactions(ctx, &TransactionRequest{BlockID: block.ID})
+		for it.Next() {
+			blockTransactions = append(blockTransactions, it.Transaction())
+		}
+		it.Close()
+
+		if err := it.Err(); err != nil {
+			return chain, err
+		}
+	}
+
+	watched := &watchedBlock{block: block, transactions: w.filterTransactions(blockTransactions)}
+	if w.matchesBlock(watched) {
+		if err := send(ctx, events, &BlockEvent{Block: block, Transactions: watched.transactions}); err != nil {
+			return chain, err
+		}
+	}
+
+	chain = append(chain, watched)
+	if maxRollback := w.getMaxRollback(); len(chain) > maxRollback {
+		chain = chain[len(chain)-maxRollback:]
+	}
+	return chain, nil
+}
+
+// rollback removes the tip of the chain and reports it
+func (w *BlockWatcher) rollback(ctx context.Context, chain []*watchedBlock, events chan<- *BlockEvent) (
+	[]*watchedBlock, error) {
+	tip := chain[len(chain)-1]
+
+	if w.matchesBlock(tip) {
+		event := &BlockEvent{Block: tip.block, Transactions: tip.transactions, Rollback: true}
+		if err := send(ctx, events, event); err != nil {
+			return chain, err
+		}
+	}
+
+	return chain[:len(chain)-1], nil
+}
+
+// getBlock returns the block at the given height of the chain of the node or nil if it doesn't exist
+func (w *BlockWatcher) getBlock(ctx context.Context, height int) (*Block, error) {
+	blockHeight := int64(height)
+
+	res, err := w.client.GetBlocks(ctx, &BlockRequest{Height: &blockHeight})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Blocks) == 0 {
+		return nil, nil
+	}
+	return res.Blocks[0], nil
+}
+
+// matchesBlock returns whether the block should be reported
+func (w *BlockWatcher) matchesBlock(watched *watchedBlock) bool {
+	if w.Filter == nil {
+		return true
+	}
+
+	if w.Filter.DelegatePublicKey != "" && watched.block.GeneratorPublicKey == w.Filter.DelegatePublicKey &&
+		w.Filter.Address == "" && w.Filter.Type == nil {
+		return true
+	}
+
+	return len(watched.transactions) > 0
+}
+
+// filterTransactions returns the transactions that match the filter
+func (w *BlockWatcher) filterTransactions(blockTransactions []*Transaction) []*Transaction {
+	if w.Filter == nil {
+		return blockTransactions
+	}
+
+	var result []*Transaction
+	for _, transaction := range blockTransactions {
+		if w.Filter.matches(transaction) {
+			result = append(result, transaction)
+		}
+	}
+	return result
+}
+
+// matches returns whether the transaction matches all criteria of the filter
+func (f *WatchFilter) matches(transaction *Transaction) bool {
+	if f.Type != nil && transaction.Type != *f.Type {
+		return false
+	}
+
+	if f.Address != "" && transaction.SenderID != f.Address && transaction.RecipientID != f.Address {
+		return false
+	}
+
+	if f.DelegatePublicKey != "" && transaction.SenderPublicKey != f.DelegatePublicKey {
+		asset, ok := transaction.Asset.(*transactions.CastVoteAsset)
+		if !ok {
+			return false
+		}
+		if !containsHexKey(asset.Votes, f.DelegatePublicKey) && !containsHexKey(asset.Unvotes, f.DelegatePublicKey) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getPollInterval returns the poll interval
+func (w *BlockWatcher) getPollInterval() time.Duration {
+	if w.PollInterval <= 0 {
+		return DefaultWatcherPollInterval
+	}
+	return w.PollInterval
+}
+
+// getMaxRollback returns the number of blocks kept to detect rollbacks
+func (w *BlockWatcher) getMaxRollback() int {
+	if w.MaxRollback <= 0 {
+		return DefaultWatcherMaxRollback
+	}
+	return w.MaxRollback
+}
+
+// send sends the event unless the context is canceled
+func send(ctx context.Context, events chan<- *BlockEvent, event *BlockEvent) error {
+	select {
+	case events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// containsHexKey returns whether the keys contain the hex encoded key
+func containsHexKey(keys [][]byte, key string) bool {
+	for _, item := range keys {
+		if hex.EncodeToString(item) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+// testChain is the chain of a node stand-in which can be switched to a fork
+type testChain struct {
+	mu           sync.Mutex
+	blocks       []*Block
+	transactions map[string][]*Transaction
+}
+
+// newTestChain returns a chain of blocks with the given IDs starting at height 1
+func newTestChain(ids ...string) *testChain {
+	chain := &testChain{transactions: make(map[string][]*Transaction)}
+	chain.switchTo(ids...)
+	return chain
+}
+
+// switchTo replaces the blocks of the chain with blocks with the given IDs
+func (c *testChain) switchTo(ids ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.blocks = nil
+	previous := ""
+	for i, id := range ids {
+		c.blocks = append(c.blocks, &Block{ID: id, Height: i + 1, PreviousBlockID: previous,
+			NumberOfTransactions: len(c.transactions[id])})
+		previous = id
+	}
+}
+
+// server returns a node stand-in that serves the chain
+func (c *testChain) server(t *testing.T) *httptest.Server {
+	return newTestAPIServer(t, testAPIRoutes{
+		"/api/node/status": func(r *http.Request) (interface{}, int) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			return &NodeStatus{Loaded: true, Height: len(c.blocks)}, http.StatusOK
+		},
+		"/api/blocks": func(r *http.Request) (interface{}, int) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			height, _ := strconv.Atoi(r.URL.Query().Get("height"))
+			if height < 1 || height > len(c.blocks) {
+				return []*Block{}, http.StatusOK
+			}
+			return []*Block{c.blocks[height-1]}, http.StatusOK
+		},
+		"/api/transactions": func(r *http.Request) (interface{}, int) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			if r.URL.Query().Get("offset") != "0" && r.URL.Query().Get("offset") != "" {
+				return []*Transaction{}, http.StatusOK
+			}
+			return c.transactions[r.URL.Query().Get("blockId")], http.StatusOK
+		},
+	})
+}
+
+// describeEvent returns the block ID of the event prefixed by - for rollbacks
+func describeEvent(event *BlockEvent) string {
+	if event.Rollback {
+		return "-" + event.Block.ID
+	}
+	return event.Block.ID
+}
+
+// receiveEvents returns the descriptions of the next n events
+func receiveEvents(t *testing.T, events <-chan *BlockEvent, n int) []string {
+	var result []string
+	for len(result) < n {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return result
+			}
+			result = append(result, describeEvent(event))
+		case <-time.After(5 * time.Second):
+			t.Errorf("BlockWatcher.Watch() reports %v; want %d events", result, n)
+			return result
+		}
+	}
+	return result
+}
+
+func TestBlockWatcher_Watch(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain []string
+		fork  []string
+		want  string
+	}{
+		{
+			name:  "growing chain",
+			chain: []string{"1", "2"},
+			fork:  []string{"1", "2", "3", "4"},
+			want:  "1 2 3 4",
+		},
+		{
+			name:  "replaced tip",
+			chain: []string{"1", "2", "3"},
+			fork:  []string{"1", "2", "3b", "4b"},
+			want:  "1 2 3 -3 3b 4b",
+		},
+		{
+			name:  "deep fork",
+			chain: []string{"1", "2", "3"},
+			fork:  []string{"1", "2b", "3b", "4b"},
+			want:  "1 2 3 -3 -2 2b 3b 4b",
+		},
+		{
+			name:  "shorter fork",
+			chain: []string{"1", "2", "3"},
+			fork:  []string{"1", "2b"},
+			want:  "1 2 3 -3 -2 2b",
+		},
+		{
+			name:  "fork below the start",
+			chain: []string{"1", "2"},
+			fork:  []string{"1b", "2b", "3b"},
+			want:  "1 2 -2 -1 1b 2b 3b",
+		},
+	}
+
+	for _, test := range tests {
+		chain := newTestChain(test.chain...)
+		server := chain.server(t)
+
+		watcher := testClient(server).NewBlockWatcher(nil)
+		watcher.PollInterval = time.Millisecond
+		watcher.StartHeight = 1
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events := watcher.Watch(ctx)
+
+		result := receiveEvents(t, events, len(test.chain))
+		chain.switchTo(test.fork...)
+		result = append(result, receiveEvents(t, events, len(strings.Fields(test.want))-len(result))...)
+
+		if got := strings.Join(result, " "); got != test.want {
+			t.Errorf("%s: BlockWatcher.Watch() reports %q; want %q", test.name, got, test.want)
+		}
+
+		cancel()
+		for range events {
+		}
+		server.Close()
+	}
+}
+
+func TestBlockWatcher_WatchRollsBackTransactions(t *testing.T) {
+	chain := newTestChain()
+	chain.transactions["2"] = []*Transaction{
+		{ID: "a", Height: 2, SenderID: "1L", RecipientID: "2L"},
+		{ID: "b", Height: 2, SenderID: "3L", RecipientID: "4L"},
+	}
+	chain.transactions["2b"] = []*Transaction{{ID: "c", Height: 2, SenderID: "3L", RecipientID: "4L"}}
+	chain.transactions["3b"] = []*Transaction{{ID: "a", Height: 3, SenderID: "1L", RecipientID: "2L"}}
+	chain.switchTo("1", "2")
+
+	server := chain.server(t)
+	defer server.Close()
+
+	watcher := testClient(server).NewBlockWatcher(&WatchFilter{Address: "2L"})
+	watcher.PollInterval = time.Millisecond
+	watcher.StartHeight = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := watcher.Watch(ctx)
+
+	// Only the blocks with transactions of the address are reported
+	want := []struct {
+		id       string
+		rollback bool
+	}{{"2", false}, {"2", true}, {"3b", false}}
+
+	for i, want := range want {
+		event := <-events
+		if event.Block.ID != want.id || event.Rollback != want.rollback || len(event.Transactions) != 1 ||
+			event.Transactions[0].ID != "a" {
+			t.Fatalf("BlockWatcher.Watch() reports %s with %v; want %s with transaction a", describeEvent(event),
+				event.Transactions, want.id)
+		}
+
+		if i == 0 {
+			chain.switchTo("1", "2b", "3b")
+		}
+	}
+}
+
+func TestWatchFilter_matches(t *testing.T) {
+	delegate, other, third := testDelegateKey(1), testDelegateKey(2), testDelegateKey(3)
+	voteType := int(transactions.TransactionTypeVote)
+
+	// The votes have spare capacity which must not be written by the filter
+	votes := make([][]byte, 1, 2)
+	votes[0] = other
+	vote := &Transaction{Type: voteType, SenderID: "1L", SenderPublicKey: "ab", RecipientID: "1L",
+		Asset: &transactions.CastVoteAsset{Votes: votes, Unvotes: [][]byte{delegate}}}
+
+	tests := []struct {
+		filter *WatchFilter
+		want   bool
+	}{
+		{&WatchFilter{Address: "1L"}, true},
+		{&WatchFilter{Address: "2L"}, false},
+		{&WatchFilter{Type: &voteType}, true},
+		{&WatchFilter{DelegatePublicKey: hex.EncodeToString(delegate)}, true},
+		{&WatchFilter{DelegatePublicKey: hex.EncodeToString(other)}, true},
+		{&WatchFilter{DelegatePublicKey: hex.EncodeToString(third)}, false},
+		{&WatchFilter{DelegatePublicKey: "ab"}, true},
+		{&WatchFilter{Address: "1L", DelegatePublicKey: hex.EncodeToString(third)}, false},
+	}
+
+	for i, test := range tests {
+		if got := test.filter.matches(vote); got != test.want {
+			t.Errorf("#%d: WatchFilter.matches()=%v; want %v", i, got, test.want)
+		}
+	}
+
+	if spare := votes[:2][1]; spare != nil {
+		t.Errorf("WatchFilter.matches() writes %x into the votes of the transaction", spare)
+	}
+}
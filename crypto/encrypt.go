@@ -3,8 +3,8 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
 	"crypto/sha256"
+	"errors"
 
 	"github.com/agl/ed25519/extra25519"
 	"github.com/kevinburke/nacl"
@@ -12,42 +12,63 @@ import (
 	"golang.org/x/crypto/pbkdf2"
 )
 
-// EncryptPassphraseWithPassword encrypts the given passphrase with the password using AES-256-CBC
+// EncryptPassphraseWithPassword encrypts the given passphrase with the password using AES-256-GCM like
+// EncryptPassphrase. The key is derived using 1e6 PBKDF2 iterations. Use EncryptPassphrase to set the iterations.
 func EncryptPassphraseWithPassword(passphrase, password string) (result, tag, iv, salt []byte, err error) {
-	iv = make([]byte, 16)
-	rand.Read(iv)
-	salt = make([]byte, 16)
-	rand.Read(salt)
+	encrypted, err := EncryptPassphrase(passphrase, password, DefaultPBKDF2Iterations)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return encrypted.CipherText, encrypted.Tag, encrypted.IV, encrypted.Salt, nil
+}
+
+// DecryptPassphraseWithPassword decrypts the given encrypted passphrase with the password using AES-256-GCM
+func DecryptPassphraseWithPassword(encryptedPassphrase, iv, salt, tag []byte, password string) (string, error) {
+	return decryptWithPassword(encryptedPassphrase, iv, salt, tag, password, DefaultPBKDF2Iterations)
+}
 
-	key := getKeyFromPassword(password, salt)
+// encryptWithPassword encrypts the passphrase using AES-256-GCM with a key derived from the password
+func encryptWithPassword(passphrase, password string, iv, salt []byte, iterations int) (result, tag []byte,
+	err error) {
+	key := getKeyFromPassword(password, salt, iterations)
 	aesBlock, err := aes.NewCipher(key)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, err
+	}
+
+	gcm, err := cipher.NewGCMWithNonceSize(aesBlock, len(iv))
+	if err != nil {
+		return nil, nil, err
 	}
 
-	gcm, err := cipher.NewGCMWithNonceSize(aesBlock, 16)
 	result = gcm.Seal(result, iv, []byte(passphrase), nil)
-	tag = result[len(result)-16:]
-	result = result[:len(result)-16]
+	tag = result[len(result)-gcm.Overhead():]
+	result = result[:len(result)-gcm.Overhead()]
 
-	return result, tag, iv, salt, err
+	return result, tag, nil
 }
 
-// DecryptPassphraseWithPassword decrypts the given encrypted passphrase with the password using AES-256-CBC
-func DecryptPassphraseWithPassword(encryptedPassphrase, iv, salt, tag []byte, password string) (string, error) {
-	key := getKeyFromPassword(password, salt)
+// decryptWithPassword decrypts the passphrase using AES-256-GCM with a key derived from the password
+func decryptWithPassword(encryptedPassphrase, iv, salt, tag []byte, password string, iterations int) (string,
+	error) {
+	key := getKeyFromPassword(password, salt, iterations)
 	aesBlock, err := aes.NewCipher(key)
 	if err != nil {
 		return "", err
 	}
 
+	if len(iv) == 0 {
+		return "", errors.New("iv must not be empty")
+	}
+
 	var result []byte
-	gcm, err := cipher.NewGCMWithNonceSize(aesBlock, 16)
+	gcm, err := cipher.NewGCMWithNonceSize(aesBlock, len(iv))
 	if err != nil {
 		return "", err
 	}
 
-	encryptedData := append(encryptedPassphrase, tag...)
+	encryptedData := append(append([]byte{}, encryptedPassphrase...), tag...)
 	result, err = gcm.Open(result, iv, encryptedData, nil)
 	if err != nil {
 		return "", err
@@ -56,12 +77,20 @@ func DecryptPassphraseWithPassword(encryptedPassphrase, iv, salt, tag []byte, pa
 	return string(result), nil
 }
 
-func getKeyFromPassword(password string, salt []byte) []byte {
-	return pbkdf2.Key([]byte(password), salt, 1e6, 32, sha256.New)
+func getKeyFromPassword(password string, salt []byte, iterations int) []byte {
+	return pbkdf2.Key([]byte(password), salt, iterations, 32, sha256.New)
 }
 
 // EncryptMessageWithPrivateKey encrypts and authenticates a message for the given recipient's public key
 func EncryptMessageWithPrivateKey(message string, privKey, recipientPubKey []byte) (packet []byte, nonce []byte) {
+	rawNonce := nacl.NewNonce()
+	packet = sealMessage(message, rawNonce, privKey, recipientPubKey)
+
+	return packet, (*rawNonce)[:]
+}
+
+// sealMessage encrypts and authenticates a message for the given recipient's public key using the nonce
+func sealMessage(message string, nonce nacl.Nonce, privKey, recipientPubKey []byte) (packet []byte) {
 	naclPrivKey := new([64]byte)
 	naclPubKey := new([32]byte)
 	convertedPrivKey := new([nacl.KeySize]byte)
@@ -73,10 +102,7 @@ func EncryptMessageWithPrivateKey(message string, privKey, recipientPubKey []byt
 	extra25519.PrivateKeyToCurve25519(convertedPrivKey, naclPrivKey)
 	extra25519.PublicKeyToCurve25519(convertedPubKey, naclPubKey)
 
-	rawNonce := nacl.NewNonce()
-	packet = box.Seal(packet, []byte(message), rawNonce, convertedPubKey, convertedPrivKey)
-
-	return packet, (*rawNonce)[:]
+	return box.Seal(packet, []byte(message), nonce, convertedPubKey, convertedPrivKey)
 }
 
 // DecryptMessageWithPrivateKey decrypts and verifies an encrypted message using the recipients private key
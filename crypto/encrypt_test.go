@@ -19,6 +19,10 @@ func TestEncryptDecryptPassphraseWithPassword(t *testing.T) {
 	if err != nil {
 		t.Errorf("EncryptPassphraseWithPassword(%v,%v) throws error: %v", defaultMessage, defaultPassword, err)
 	}
+	if len(iv) != 12 || len(salt) != 16 {
+		t.Errorf("EncryptPassphraseWithPassword() uses an iv of %d and a salt of %d bytes; want 12 and 16", len(iv),
+			len(salt))
+	}
 
 	result, err := DecryptPassphraseWithPassword(data, iv, salt, tag, defaultPassword)
 	if err != nil {
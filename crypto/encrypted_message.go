@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/kevinburke/nacl"
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// EncryptedMessage is a message encrypted for a recipient in the format of Lisk Elements
+	EncryptedMessage struct {
+		// EncryptedMessage is the hex encoded NaCl box of the message
+		EncryptedMessage string `json:"encryptedMessage"`
+		// Nonce is the hex encoded nonce of the box
+		Nonce string `json:"nonce"`
+	}
+)
+
+// EncryptMessageWithPassphrase encrypts and authenticates a message for the recipient's public key using the
+// private key of the passphrase like encryptMessageWithPassphrase of Lisk Elements
+func EncryptMessageWithPassphrase(message, passphrase string, recipientPublicKey []byte) (*EncryptedMessage, error) {
+	return encryptMessageWithPassphrase(message, passphrase, recipientPublicKey, nacl.NewNonce())
+}
+
+// DecryptMessageWithPassphrase decrypts and verifies a message of the sender using the private key of the
+// passphrase like decryptMessageWithPassphrase of Lisk Elements
+func DecryptMessageWithPassphrase(encrypted *EncryptedMessage, passphrase string, senderPublicKey []byte) (string,
+	error) {
+	if len(senderPublicKey) != ed25519.PublicKeySize {
+		return "", errors.New("invalid sender public key size")
+	}
+
+	packet, err := hex.DecodeString(encrypted.EncryptedMessage)
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted message: %v", err)
+	}
+
+	nonce, err := hex.DecodeString(encrypted.Nonce)
+	if err != nil || len(nonce) != nacl.NonceSize {
+		return "", errors.New("invalid nonce")
+	}
+
+	message, ok := DecryptMessageWithPrivateKey(packet, nonce, GetPrivateKeyFromSecret(passphrase), senderPublicKey)
+	if !ok {
+		return "", errors.New("message cannot be decrypted")
+	}
+
+	return message, nil
+}
+
+// encryptMessageWithPassphrase encrypts the message using the given nonce
+func encryptMessageWithPassphrase(message, passphrase string, recipientPublicKey []byte, nonce nacl.Nonce) (
+	*EncryptedMessage, error) {
+	if len(recipientPublicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid recipient public key size")
+	}
+
+	packet := sealMessage(message, nonce, GetPrivateKeyFromSecret(passphrase), recipientPublicKey)
+
+	return &EncryptedMessage{
+		EncryptedMessage: hex.EncodeToString(packet),
+		Nonce:            hex.EncodeToString(nonce[:]),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevinburke/nacl"
+)
+
+var (
+	// encryptedMessageElements was encrypted with tweetnacl and ed2curve like Lisk Elements does
+	encryptedMessageElements = &EncryptedMessage{
+		EncryptedMessage: "506f84a1f2fa843ebd4207a37eb0e632c3b3675dcf5546da45459058a7e29b",
+		Nonce:            "000102030405060708090a0b0c0d0e0f1011121314151617",
+	}
+	encryptedMessagePlain        = "Hello Lisk! ✓"
+	encryptedMessageSender       = "wagon stock borrow episode laundry kitten salute link globe zero feed marble"
+	encryptedMessageRecipient    = "second"
+	encryptedMessageSenderKey    = GetPublicKeyFromSecret(encryptedMessageSender)
+	encryptedMessageRecipientKey = GetPublicKeyFromSecret(encryptedMessageRecipient)
+)
+
+func TestEncryptMessageWithPassphrase(t *testing.T) {
+	nonce := new([nacl.NonceSize]byte)
+	hex.Decode(nonce[:], []byte(encryptedMessageElements.Nonce))
+
+	encrypted, err := encryptMessageWithPassphrase(encryptedMessagePlain, encryptedMessageSender,
+		encryptedMessageRecipientKey, nonce)
+	if err != nil {
+		t.Fatalf("encryptMessageWithPassphrase() returns error: %v", err)
+	}
+
+	if *encrypted != *encryptedMessageElements {
+		t.Errorf("encryptMessageWithPassphrase()=%v; want %v", encrypted, encryptedMessageElements)
+	}
+
+	encrypted, err = EncryptMessageWithPassphrase(encryptedMessagePlain, encryptedMessageSender,
+		encryptedMessageRecipientKey)
+	if err != nil {
+		t.Fatalf("EncryptMessageWithPassphrase() returns error: %v", err)
+	}
+
+	message, err := DecryptMessageWithPassphrase(encrypted, encryptedMessageRecipient, encryptedMessageSenderKey)
+	if err != nil || message != encryptedMessagePlain {
+		t.Errorf("DecryptMessageWithPassphrase(EncryptMessageWithPassphrase())=%v,%v; want %v,nil", message, err,
+			encryptedMessagePlain)
+	}
+
+	if _, err := EncryptMessageWithPassphrase(encryptedMessagePlain, encryptedMessageSender, []byte{1}); err == nil {
+		t.Error("EncryptMessageWithPassphrase(invalid public key) does not return an error")
+	}
+}
+
+func TestDecryptMessageWithPassphrase(t *testing.T) {
+	message, err := DecryptMessageWithPassphrase(encryptedMessageElements, encryptedMessageRecipient,
+		encryptedMessageSenderKey)
+	if err != nil || message != encryptedMessagePlain {
+		t.Errorf("DecryptMessageWithPassphrase()=%v,%v; want %v,nil", message, err, encryptedMessagePlain)
+	}
+
+	if _, err := DecryptMessageWithPassphrase(encryptedMessageElements, encryptedMessageSender,
+		encryptedMessageSenderKey); err == nil {
+		t.Error("DecryptMessageWithPassphrase(wrong passphrase) does not return an error")
+	}
+
+	invalid := []*EncryptedMessage{
+		{EncryptedMessage: "zz", Nonce: encryptedMessageElements.Nonce},
+		{EncryptedMessage: encryptedMessageElements.EncryptedMessage, Nonce: "0001"},
+		{EncryptedMessage: encryptedMessageElements.EncryptedMessage[2:], Nonce: encryptedMessageElements.Nonce},
+	}
+
+	for i, encrypted := range invalid {
+		if _, err := DecryptMessageWithPassphrase(encrypted, encryptedMessageRecipient,
+			encryptedMessageSenderKey); err == nil {
+			t.Errorf("#%d: DecryptMessageWithPassphrase(%v) does not return an error", i, encrypted)
+		}
+	}
+}
+
+func TestEncryptedMessageJSON(t *testing.T) {
+	data, _ := json.Marshal(encryptedMessageElements)
+
+	want := `{"encryptedMessage":"` + encryptedMessageElements.EncryptedMessage + `","nonce":"` +
+		encryptedMessageElements.Nonce + `"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal(EncryptedMessage)=%s; want %s", data, want)
+	}
+}
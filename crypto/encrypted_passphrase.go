@@ -0,0 +1,157 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// EncryptedPassphrase is a passphrase encrypted with a password in the format used by Lisk Elements and Lisk Hub.
+	// Its string form is iterations=…&cipherText=…&iv=…&salt=…&tag=…&version=1
+	EncryptedPassphrase struct {
+		// Iterations is the number of PBKDF2 iterations used to derive the key from the password
+		Iterations int
+		// CipherText is the encrypted passphrase
+		CipherText []byte
+		// IV is the initialization vector of the cipher
+		IV []byte
+		// Salt is the salt of the key derivation
+		Salt []byte
+		// Tag is the authentication tag of the cipher
+		Tag []byte
+		// Version is the version of the format
+		Version string
+	}
+)
+
+const (
+	// EncryptedPassphraseVersion is the supported version of the encrypted passphrase format
+	EncryptedPassphraseVersion = "1"
+	// DefaultPBKDF2Iterations is the default number of PBKDF2 iterations used to derive the key from the password
+	DefaultPBKDF2Iterations = 1000000
+
+	// encryptedPassphraseIVSize is the size of the IV used by Lisk Elements
+	encryptedPassphraseIVSize = 12
+	// encryptedPassphraseSaltSize is the size of the salt used by Lisk Elements
+	encryptedPassphraseSaltSize = 16
+)
+
+// EncryptPassphrase encrypts the passphrase with the password using AES-256-GCM.
+// The key is derived using the given number of PBKDF2 iterations. DefaultPBKDF2Iterations is used if it's 0.
+func EncryptPassphrase(passphrase, password string, iterations int) (*EncryptedPassphrase, error) {
+	if iterations < 0 {
+		return nil, errors.New("iterations must not be negative")
+	}
+	if iterations == 0 {
+		iterations = DefaultPBKDF2Iterations
+	}
+
+	iv := make([]byte, encryptedPassphraseIVSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	salt := make([]byte, encryptedPassphraseSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	cipherText, tag, err := encryptWithPassword(passphrase, password, iv, salt, iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedPassphrase{
+		Iterations: iterations,
+		CipherText: cipherText,
+		IV:         iv,
+		Salt:       salt,
+		Tag:        tag,
+		Version:    EncryptedPassphraseVersion,
+	}, nil
+}
+
+// ParseEncryptedPassphrase parses the string form of an encrypted passphrase.
+// DefaultPBKDF2Iterations is used if the iterations are missing.
+func ParseEncryptedPassphrase(s string) (*EncryptedPassphrase, error) {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted passphrase: %v", err)
+	}
+
+	p := &EncryptedPassphrase{
+		Iterations: DefaultPBKDF2Iterations,
+		Version:    values.Get("version"),
+	}
+
+	if p.Version != EncryptedPassphraseVersion {
+		return nil, fmt.Errorf("unsupported encrypted passphrase version %q", p.Version)
+	}
+
+	if iterations := values.Get("iterations"); iterations != "" {
+		if p.Iterations, err = strconv.Atoi(iterations); err != nil || p.Iterations <= 0 {
+			return nil, fmt.Errorf("invalid iterations %q", iterations)
+		}
+	}
+
+	fields := []struct {
+		name  string
+		value *[]byte
+	}{
+		{"cipherText", &p.CipherText},
+		{"iv", &p.IV},
+		{"salt", &p.Salt},
+		{"tag", &p.Tag},
+	}
+
+	for _, field := range fields {
+		value := values.Get(field.name)
+		if value == "" {
+			return nil, fmt.Errorf("missing %s", field.name)
+		}
+
+		if *field.value, err = hex.DecodeString(value); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", field.name, err)
+		}
+	}
+
+	return p, nil
+}
+
+// String returns the string form of the encrypted passphrase
+func (p *EncryptedPassphrase) String() string {
+	return fmt.Sprintf("iterations=%d&cipherText=%x&iv=%x&salt=%x&tag=%x&version=%s", p.Iterations, p.CipherText,
+		p.IV, p.Salt, p.Tag, url.QueryEscape(p.Version))
+}
+
+// Decrypt decrypts the passphrase with the password
+func (p *EncryptedPassphrase) Decrypt(password string) (string, error) {
+	if p.Version != EncryptedPassphraseVersion {
+		return "", fmt.Errorf("unsupported encrypted passphrase version %q", p.Version)
+	}
+
+	if p.Iterations <= 0 {
+		return "", errors.New("iterations must be greater than 0")
+	}
+
+	return decryptWithPassword(p.CipherText, p.IV, p.Salt, p.Tag, password, p.Iterations)
+}
+
+// MarshalText returns the string form of the encrypted passphrase
+func (p *EncryptedPassphrase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText parses the string form of an encrypted passphrase
+func (p *EncryptedPassphrase) UnmarshalText(text []byte) error {
+	parsed, err := ParseEncryptedPassphrase(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = *parsed
+	return nil
+}
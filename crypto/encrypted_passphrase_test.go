@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const (
+	// encryptedPassphraseElements was created with AES-256-GCM and PBKDF2 like Lisk Elements does
+	encryptedPassphraseElements = "iterations=10&cipherText=e9f1ae0942a4d08ed7e70fa59ab6a9bf8743bb2187be8c92e6c8eeb2b" +
+		"678eb8ab5687dd536517edab59914d07398db97624174abdc1e058997130a374509ed542ab7ef47acf29a032f4718d7" +
+		"&iv=0123456789abcdef01234567&salt=00112233445566778899aabbccddeeff&tag=581ea1093ef25f4dc627736dff77993b" +
+		"&version=1"
+	encryptedPassphrasePassword = "myTotal53cr3t%&"
+	encryptedPassphrasePlain    = "wagon stock borrow episode laundry kitten salute link globe zero feed marble"
+)
+
+func TestParseEncryptedPassphrase(t *testing.T) {
+	p, err := ParseEncryptedPassphrase(encryptedPassphraseElements)
+	if err != nil {
+		t.Fatalf("ParseEncryptedPassphrase() returns error: %v", err)
+	}
+
+	if p.Iterations != 10 || len(p.IV) != 12 || len(p.Salt) != 16 || len(p.Tag) != 16 || p.Version != "1" {
+		t.Errorf("ParseEncryptedPassphrase() returns %+v", p)
+	}
+
+	if s := p.String(); s != encryptedPassphraseElements {
+		t.Errorf("EncryptedPassphrase.String()=%v; want %v", s, encryptedPassphraseElements)
+	}
+
+	passphrase, err := p.Decrypt(encryptedPassphrasePassword)
+	if err != nil || passphrase != encryptedPassphrasePlain {
+		t.Errorf("EncryptedPassphrase.Decrypt()=%v,%v; want %v,nil", passphrase, err, encryptedPassphrasePlain)
+	}
+
+	if _, err := p.Decrypt("wrong"); err == nil {
+		t.Error("EncryptedPassphrase.Decrypt(wrong password) does not return an error")
+	}
+}
+
+func TestParseEncryptedPassphraseInvalid(t *testing.T) {
+	tests := []string{
+		strings.Replace(encryptedPassphraseElements, "version=1", "version=2", 1),
+		strings.Replace(encryptedPassphraseElements, "&version=1", "", 1),
+		strings.Replace(encryptedPassphraseElements, "iterations=10", "iterations=-1", 1),
+		strings.Replace(encryptedPassphraseElements, "iterations=10", "iterations=abc", 1),
+		strings.Replace(encryptedPassphraseElements, "&tag=581ea1093ef25f4dc627736dff77993b", "", 1),
+		strings.Replace(encryptedPassphraseElements, "salt=00", "salt=0g", 1),
+	}
+
+	for i, test := range tests {
+		if p, err := ParseEncryptedPassphrase(test); err == nil {
+			t.Errorf("#%d: ParseEncryptedPassphrase()=%v; want error", i, p)
+		}
+	}
+
+	p, err := ParseEncryptedPassphrase(strings.Replace(encryptedPassphraseElements, "iterations=10&", "", 1))
+	if err != nil || p.Iterations != DefaultPBKDF2Iterations {
+		t.Errorf("ParseEncryptedPassphrase(without iterations) returns %v,%v; want default iterations", p, err)
+	}
+}
+
+func TestEncryptPassphrase(t *testing.T) {
+	p, err := EncryptPassphrase(encryptedPassphrasePlain, encryptedPassphrasePassword, 100)
+	if err != nil {
+		t.Fatalf("EncryptPassphrase() returns error: %v", err)
+	}
+
+	parsed, err := ParseEncryptedPassphrase(p.String())
+	if err != nil {
+		t.Fatalf("ParseEncryptedPassphrase(EncryptPassphrase()) returns error: %v", err)
+	}
+
+	if parsed.Iterations != 100 {
+		t.Errorf("EncryptPassphrase() uses %d iterations; want 100", parsed.Iterations)
+	}
+
+	passphrase, err := parsed.Decrypt(encryptedPassphrasePassword)
+	if err != nil || passphrase != encryptedPassphrasePlain {
+		t.Errorf("EncryptedPassphrase.Decrypt()=%v,%v; want %v,nil", passphrase, err, encryptedPassphrasePlain)
+	}
+
+	if _, err := EncryptPassphrase(encryptedPassphrasePlain, encryptedPassphrasePassword, -1); err == nil {
+		t.Error("EncryptPassphrase(negative iterations) does not return an error")
+	}
+}
+
+func TestEncryptedPassphrase_MarshalText(t *testing.T) {
+	p, _ := ParseEncryptedPassphrase(encryptedPassphraseElements)
+
+	data, err := json.Marshal(map[string]*EncryptedPassphrase{"passphrase": p})
+	if err != nil {
+		t.Fatalf("json.Marshal() returns error: %v", err)
+	}
+
+	var result map[string]*EncryptedPassphrase
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("json.Unmarshal() returns error: %v", err)
+	}
+
+	if result["passphrase"].String() != encryptedPassphraseElements {
+		t.Errorf("json.Unmarshal(json.Marshal())=%v; want %v", result["passphrase"], encryptedPassphraseElements)
+	}
+}
@@ -7,7 +7,8 @@ import (
 	"golang.org/x/crypto/ed25519"
 )
 
-// GetPrivateKeyFromSecret takes a Lisk secret and returns the associated private key
+// GetPrivateKeyFromSecret takes a Lisk secret and returns the associated private key.
+// Any string is accepted. Use CheckPassphrase or GetPrivateKeyFromPassphrase to detect typos in passphrases.
 func GetPrivateKeyFromSecret(secret string) []byte {
 	secretHash := GetSHA256Hash(secret)
 	_, prKey, _ := ed25519.GenerateKey(bytes.NewReader(secretHash[:sha256.Size]))
@@ -15,6 +16,16 @@ func GetPrivateKeyFromSecret(secret string) []byte {
 	return prKey
 }
 
+// GetPrivateKeyFromPassphrase checks the passphrase using CheckPassphrase and returns the associated private key.
+// The PassphraseWarnings are returned instead if the passphrase is not a valid Lisk passphrase.
+func GetPrivateKeyFromPassphrase(passphrase string) ([]byte, error) {
+	if err := CheckPassphrase(passphrase); err != nil {
+		return nil, err
+	}
+
+	return GetPrivateKeyFromSecret(passphrase), nil
+}
+
 // GetPublicKeyFromSecret takes a Lisk secret and returns the associated public key
 func GetPublicKeyFromSecret(secret string) []byte {
 	secretHash := GetSHA256Hash(secret)
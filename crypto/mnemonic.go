@@ -0,0 +1,179 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type (
+	// PassphraseWarnings are the reasons why a passphrase is not a valid Lisk passphrase
+	PassphraseWarnings []string
+)
+
+const (
+	// PassphraseWords is the number of words of a Lisk passphrase
+	PassphraseWords = 12
+
+	// passphraseEntropySize is the entropy in bytes of a 12 word mnemonic
+	passphraseEntropySize = 16
+	// mnemonicWordBits is the number of bits encoded by a word
+	mnemonicWordBits = 11
+)
+
+var (
+	// englishWordIndex maps the words of the English wordlist to their index
+	englishWordIndex = make(map[string]int, len(englishWordlist))
+)
+
+func init() {
+	for i, word := range englishWordlist {
+		englishWordIndex[word] = i
+	}
+}
+
+// Error returns all warnings separated by semicolons
+func (w PassphraseWarnings) Error() string {
+	return strings.Join(w, "; ")
+}
+
+// GenerateMnemonic returns a new random 12 word BIP39 mnemonic which can be used as a Lisk passphrase
+func GenerateMnemonic() (string, error) {
+	entropy := make([]byte, passphraseEntropySize)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+
+	return NewMnemonic(entropy)
+}
+
+// NewMnemonic returns the BIP39 mnemonic of the entropy.
+// The entropy must have 16 to 32 bytes and a multiple of 4 bytes.
+func NewMnemonic(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", errors.New("entropy must have 16 to 32 bytes and a multiple of 4 bytes")
+	}
+
+	hash := sha256.Sum256(entropy)
+	checksumBits := len(entropy) * 8 / 32
+
+	data := append(append([]byte{}, entropy...), hash[0])
+	wordCount := (len(entropy)*8 + checksumBits) / mnemonicWordBits
+
+	words := make([]string, wordCount)
+	for i := range words {
+		words[i] = englishWordlist[readBits(data, i*mnemonicWordBits, mnemonicWordBits)]
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// ValidateMnemonic checks whether the mnemonic consists of words of the English BIP39 wordlist separated by single
+// spaces and has a valid checksum
+func ValidateMnemonic(mnemonic string) error {
+	words := strings.Split(mnemonic, " ")
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return fmt.Errorf("invalid number of words: %d", len(words))
+	}
+
+	var unknown []string
+	for _, word := range words {
+		if _, ok := englishWordIndex[word]; !ok {
+			unknown = append(unknown, word)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown words: %s", strings.Join(unknown, ", "))
+	}
+
+	totalBits := len(words) * mnemonicWordBits
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+
+	data := make([]byte, (totalBits+7)/8)
+	for i, word := range words {
+		writeBits(data, i*mnemonicWordBits, mnemonicWordBits, englishWordIndex[word])
+	}
+
+	hash := sha256.Sum256(data[:entropyBits/8])
+	if readBits(data, entropyBits, checksumBits) != readBits(hash[:], 0, checksumBits) {
+		return errors.New("invalid checksum")
+	}
+
+	return nil
+}
+
+// IsValidMnemonic returns whether the mnemonic is a valid BIP39 mnemonic
+func IsValidMnemonic(mnemonic string) bool {
+	return ValidateMnemonic(mnemonic) == nil
+}
+
+// CheckPassphrase checks whether the passphrase is a valid Lisk passphrase, a 12 word BIP39 mnemonic in lowercase
+// separated by single spaces. Any string can be used as passphrase but a typo in a passphrase which is not checked
+// results in a different account.
+// It returns PassphraseWarnings describing all problems or nil if the passphrase is valid.
+func CheckPassphrase(passphrase string) error {
+	var warnings PassphraseWarnings
+
+	if strings.TrimSpace(passphrase) != passphrase {
+		warnings = append(warnings, "passphrase has leading or trailing whitespace")
+	}
+
+	words := strings.Fields(passphrase)
+	if strings.Join(words, " ") != strings.TrimSpace(passphrase) {
+		warnings = append(warnings, "words are not separated by single spaces")
+	}
+
+	if strings.IndexFunc(passphrase, unicode.IsUpper) >= 0 {
+		warnings = append(warnings, "passphrase contains uppercase characters")
+	}
+
+	if len(words) != PassphraseWords {
+		warnings = append(warnings, fmt.Sprintf("passphrase has %d words instead of %d", len(words),
+			PassphraseWords))
+	}
+
+	var unknown []string
+	for _, word := range words {
+		if _, ok := englishWordIndex[strings.ToLower(word)]; !ok {
+			unknown = append(unknown, word)
+		}
+	}
+	if len(unknown) > 0 {
+		warnings = append(warnings, "passphrase contains words which are not in the BIP39 wordlist: "+
+			strings.Join(unknown, ", "))
+	}
+
+	if len(warnings) == 0 && !IsValidMnemonic(passphrase) {
+		warnings = append(warnings, "passphrase has an invalid BIP39 checksum")
+	}
+
+	if len(warnings) > 0 {
+		return warnings
+	}
+	return nil
+}
+
+// readBits returns the count bits of data starting at the bit offset
+func readBits(data []byte, offset, count int) int {
+	var result int
+	for i := offset; i < offset+count; i++ {
+		result <<= 1
+		if data[i/8]&(0x80>>uint(i%8)) != 0 {
+			result |= 1
+		}
+	}
+	return result
+}
+
+// writeBits writes the lowest count bits of value to data starting at the bit offset
+func writeBits(data []byte, offset, count, value int) {
+	for i := 0; i < count; i++ {
+		if value&(1<<uint(count-1-i)) != 0 {
+			data[(offset+i)/8] |= 0x80 >> uint((offset+i)%8)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestNewMnemonic(t *testing.T) {
+	// Test vectors of the BIP39 specification
+	tests := []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"},
+		{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow"},
+		{"80808080808080808080808080808080",
+			"letter advice cage absurd amount doctor acoustic avoid letter advice cage above"},
+		{"ffffffffffffffffffffffffffffffff", "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong"},
+		{"9e885d952ad362caeb4efe34a8e91bd2",
+			"ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic"},
+		{"0000000000000000000000000000000000000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+				"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art"},
+	}
+
+	for _, test := range tests {
+		entropy, _ := hex.DecodeString(test.entropy)
+
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil || mnemonic != test.mnemonic {
+			t.Errorf("NewMnemonic(%v)=%v,%v; want %v", test.entropy, mnemonic, err, test.mnemonic)
+		}
+
+		if err := ValidateMnemonic(test.mnemonic); err != nil {
+			t.Errorf("ValidateMnemonic(%v) returns error: %v", test.mnemonic, err)
+		}
+	}
+
+	if _, err := NewMnemonic(make([]byte, 15)); err == nil {
+		t.Error("NewMnemonic(15 bytes) does not return an error")
+	}
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	mnemonic, err := GenerateMnemonic()
+	if err != nil {
+		t.Fatalf("GenerateMnemonic() returns error: %v", err)
+	}
+
+	if err := CheckPassphrase(mnemonic); err != nil {
+		t.Errorf("CheckPassphrase(GenerateMnemonic()) returns error: %v", err)
+	}
+
+	other, _ := GenerateMnemonic()
+	if other == mnemonic {
+		t.Error("GenerateMnemonic() returns the same mnemonic twice")
+	}
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	tests := []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon lisk",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon  about",
+	}
+
+	for _, test := range tests {
+		if err := ValidateMnemonic(test); err == nil {
+			t.Errorf("ValidateMnemonic(%v) does not return an error", test)
+		}
+	}
+}
+
+func TestCheckPassphrase(t *testing.T) {
+	valid := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	tests := []struct {
+		in       string
+		warnings []string
+	}{
+		{valid, nil},
+		{" " + valid, []string{"leading or trailing whitespace"}},
+		{strings.Replace(valid, " ", "  ", 1), []string{"single spaces"}},
+		{strings.Replace(valid, "legal", "Legal", 1), []string{"uppercase"}},
+		{strings.Replace(valid, " yellow", "", 1), []string{"11 words"}},
+		{strings.Replace(valid, "yellow", "lisk", 1), []string{"wordlist: lisk"}},
+		{strings.Replace(valid, "yellow", "year", 1), []string{"checksum"}},
+		{"Lisk  ", []string{"whitespace", "uppercase", "1 words", "wordlist: Lisk"}},
+	}
+
+	for _, test := range tests {
+		err := CheckPassphrase(test.in)
+		if test.warnings == nil {
+			if err != nil {
+				t.Errorf("CheckPassphrase(%q) returns error: %v", test.in, err)
+			}
+			continue
+		}
+
+		warnings, ok := err.(PassphraseWarnings)
+		if !ok || len(warnings) != len(test.warnings) {
+			t.Errorf("CheckPassphrase(%q)=%v; want warnings %v", test.in, err, test.warnings)
+			continue
+		}
+
+		for i, warning := range test.warnings {
+			if !strings.Contains(warnings[i], warning) {
+				t.Errorf("CheckPassphrase(%q) warns %q; want %q", test.in, warnings[i], warning)
+			}
+		}
+	}
+}
+
+func TestGetPrivateKeyFromPassphrase(t *testing.T) {
+	valid := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	privateKey, err := GetPrivateKeyFromPassphrase(valid)
+	if err != nil || hex.EncodeToString(privateKey) != hex.EncodeToString(GetPrivateKeyFromSecret(valid)) {
+		t.Errorf("GetPrivateKeyFromPassphrase(%v)=%x,%v; want the key of the secret", valid, privateKey, err)
+	}
+
+	if _, err := GetPrivateKeyFromPassphrase(strings.ToUpper(valid)); err == nil {
+		t.Error("GetPrivateKeyFromPassphrase(uppercase) does not return an error")
+	}
+}
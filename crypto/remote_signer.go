@@ -0,0 +1,114 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// RemoteSigner is a Signer that forwards signing requests to a separate signing process.
+	// The signing process is reached over a (local) socket and speaks JSON-RPC 1.0,
+	// so it can be implemented in any language. ServeSigner implements the Go side of it.
+	RemoteSigner struct {
+		client    *rpc.Client
+		publicKey []byte
+	}
+
+	// signerService exposes a Signer over RPC
+	signerService struct {
+		signer Signer
+	}
+)
+
+const (
+	// signerServiceName is the name of the RPC service of the signing process
+	signerServiceName = "Signer"
+)
+
+// DialRemoteSigner connects to the signing process listening on the given address.
+// Network is the network type as used by net.Dial, e.g. "unix" for a unix socket.
+func DialRemoteSigner(network, address string) (*RemoteSigner, error) {
+	client, err := jsonrpc.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey []byte
+	if err := client.Call(signerServiceName+".PublicKey", struct{}{}, &publicKey); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("cannot get public key from signer: %v", err)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize {
+		client.Close()
+		return nil, errors.New("signer returned a public key with invalid size")
+	}
+
+	return &RemoteSigner{
+		client:    client,
+		publicKey: publicKey,
+	}, nil
+}
+
+// PublicKey returns the public key of the remote signer
+func (r *RemoteSigner) PublicKey() []byte {
+	return r.publicKey
+}
+
+// Sign lets the remote process sign the digest and verifies the returned signature
+func (r *RemoteSigner) Sign(digest []byte) ([]byte, error) {
+	var signature []byte
+	if err := r.client.Call(signerServiceName+".Sign", digest, &signature); err != nil {
+		return nil, err
+	}
+
+	if valid, _ := VerifyDataWithPublicKey(digest, signature, r.publicKey); !valid {
+		return nil, errors.New("signer returned an invalid signature")
+	}
+
+	return signature, nil
+}
+
+// Close closes the connection to the signing process
+func (r *RemoteSigner) Close() error {
+	return r.client.Close()
+}
+
+// ServeSigner serves signing requests for the signer on the listener until the listener is closed.
+// It is meant to run in a separate signing process that is the only one with access to the key.
+func ServeSigner(listener net.Listener, signer Signer) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(signerServiceName, &signerService{signer: signer}); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// PublicKey returns the public key of the signer
+func (s *signerService) PublicKey(_ struct{}, publicKey *[]byte) error {
+	*publicKey = s.signer.PublicKey()
+	return nil
+}
+
+// Sign signs the digest
+func (s *signerService) Sign(digest []byte, signature *[]byte) error {
+	result, err := s.signer.Sign(digest)
+	if err != nil {
+		return err
+	}
+
+	*signature = result
+	return nil
+}
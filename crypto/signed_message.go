@@ -0,0 +1,223 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// SignedMessage is a message signed in the format of Lisk Elements and Lisk Hub
+	SignedMessage struct {
+		// Message is the signed message
+		Message string
+		// PublicKey of the signer
+		PublicKey []byte
+		// Signature of the message digest
+		Signature []byte
+		// SecondPublicKey is the second public key of the signer if the message has a second signature
+		SecondPublicKey []byte
+		// SecondSignature of the message digest using the second passphrase
+		SecondSignature []byte
+	}
+)
+
+const (
+	// signedMessagePrefix is prepended to messages before they are signed
+	signedMessagePrefix = "Lisk Signed Message:\n"
+
+	signedMessageHeader                = "-----BEGIN LISK SIGNED MESSAGE-----"
+	signedMessageMessageHeader         = "-----MESSAGE-----"
+	signedMessagePublicKeyHeader       = "-----PUBLIC KEY-----"
+	signedMessageSecondPublicKeyHeader = "-----SECOND PUBLIC KEY-----"
+	signedMessageSignatureHeader       = "-----SIGNATURE-----"
+	signedMessageSecondSignatureHeader = "-----SECOND SIGNATURE-----"
+	signedMessageFooter                = "-----END LISK SIGNED MESSAGE-----"
+)
+
+// DigestMessage returns the digest of the message which is signed by Lisk wallets.
+// It's the double SHA-256 hash of the prefix "Lisk Signed Message:\n" and the message, each preceded by its length
+// as varint.
+func DigestMessage(message string) []byte {
+	var buf bytes.Buffer
+
+	writeVarint(&buf, uint64(len(signedMessagePrefix)))
+	buf.WriteString(signedMessagePrefix)
+	// Lisk Elements uses the length of the JavaScript string which counts UTF-16 code units
+	writeVarint(&buf, uint64(len(utf16.Encode([]rune(message)))))
+	buf.WriteString(message)
+
+	hash := sha256.Sum256(buf.Bytes())
+	hash = sha256.Sum256(hash[:])
+	return hash[:]
+}
+
+// SignMessage signs the message like Lisk wallets do.
+// The second signer is optional and only required to add a second signature.
+func SignMessage(message string, signer Signer, secondSigner Signer) (*SignedMessage, error) {
+	if signer == nil {
+		return nil, errors.New("signer must not be nil")
+	}
+
+	digest := DigestMessage(message)
+
+	signature, err := signer.Sign(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := &SignedMessage{
+		Message:   message,
+		PublicKey: signer.PublicKey(),
+		Signature: signature,
+	}
+
+	if secondSigner != nil {
+		if signed.SecondSignature, err = secondSigner.Sign(digest); err != nil {
+			return nil, err
+		}
+		signed.SecondPublicKey = secondSigner.PublicKey()
+	}
+
+	return signed, nil
+}
+
+// VerifyMessage verifies the signature and the second signature of the message if it has one
+func VerifyMessage(signed *SignedMessage) (bool, error) {
+	if len(signed.PublicKey) != ed25519.PublicKeySize {
+		return false, errors.New("invalid public key size")
+	}
+
+	if len(signed.SecondPublicKey) != 0 || len(signed.SecondSignature) != 0 {
+		if len(signed.SecondPublicKey) != ed25519.PublicKeySize {
+			return false, errors.New("invalid second public key size")
+		}
+		if len(signed.SecondSignature) == 0 {
+			return false, errors.New("missing second signature")
+		}
+	}
+
+	digest := DigestMessage(signed.Message)
+
+	if !ed25519.Verify(signed.PublicKey, digest, signed.Signature) {
+		return false, nil
+	}
+
+	if len(signed.SecondPublicKey) != 0 && !ed25519.Verify(signed.SecondPublicKey, digest, signed.SecondSignature) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// String returns the signed message as -----BEGIN LISK SIGNED MESSAGE----- block
+func (m *SignedMessage) String() string {
+	lines := []string{
+		signedMessageHeader,
+		signedMessageMessageHeader,
+		m.Message,
+		signedMessagePublicKeyHeader,
+		hex.EncodeToString(m.PublicKey),
+	}
+
+	if len(m.SecondPublicKey) != 0 {
+		lines = append(lines, signedMessageSecondPublicKeyHeader, hex.EncodeToString(m.SecondPublicKey))
+	}
+
+	lines = append(lines, signedMessageSignatureHeader, hex.EncodeToString(m.Signature))
+
+	if len(m.SecondSignature) != 0 {
+		lines = append(lines, signedMessageSecondSignatureHeader, hex.EncodeToString(m.SecondSignature))
+	}
+
+	lines = append(lines, signedMessageFooter)
+
+	return strings.Join(lines, "\n")
+}
+
+// ParseSignedMessage parses a -----BEGIN LISK SIGNED MESSAGE----- block. The signatures are not verified.
+func ParseSignedMessage(s string) (*SignedMessage, error) {
+	lines := strings.Split(strings.Replace(strings.TrimSpace(s), "\r\n", "\n", -1), "\n")
+
+	if len(lines) < 7 || lines[0] != signedMessageHeader || lines[1] != signedMessageMessageHeader ||
+		lines[len(lines)-1] != signedMessageFooter {
+		return nil, errors.New("invalid signed message block")
+	}
+
+	// The message may span multiple lines, so the public key header is searched from the end
+	messageEnd := -1
+	for i := len(lines) - 2; i >= 2; i-- {
+		if lines[i] == signedMessagePublicKeyHeader {
+			messageEnd = i
+			break
+		}
+	}
+	if messageEnd < 0 {
+		return nil, errors.New("missing public key")
+	}
+
+	signed := &SignedMessage{Message: strings.Join(lines[2:messageEnd], "\n")}
+
+	fields := map[string]*[]byte{
+		signedMessagePublicKeyHeader:       &signed.PublicKey,
+		signedMessageSecondPublicKeyHeader: &signed.SecondPublicKey,
+		signedMessageSignatureHeader:       &signed.Signature,
+		signedMessageSecondSignatureHeader: &signed.SecondSignature,
+	}
+
+	rest := lines[messageEnd : len(lines)-1]
+	if len(rest)%2 != 0 {
+		return nil, errors.New("invalid signed message block")
+	}
+
+	for i := 0; i < len(rest); i += 2 {
+		field, ok := fields[rest[i]]
+		if !ok {
+			return nil, fmt.Errorf("unexpected line %q", rest[i])
+		}
+		if *field != nil {
+			return nil, fmt.Errorf("duplicate %s", rest[i])
+		}
+
+		value, err := hex.DecodeString(rest[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s: %v", rest[i], err)
+		}
+		*field = value
+	}
+
+	if len(signed.Signature) == 0 {
+		return nil, errors.New("missing signature")
+	}
+
+	return signed, nil
+}
+
+// writeVarint writes the value as Bitcoin style varint
+func writeVarint(buf *bytes.Buffer, value uint64) {
+	var data [9]byte
+
+	switch {
+	case value < 0xfd:
+		buf.WriteByte(byte(value))
+	case value <= 0xffff:
+		data[0] = 0xfd
+		binary.LittleEndian.PutUint16(data[1:], uint16(value))
+		buf.Write(data[:3])
+	case value <= 0xffffffff:
+		data[0] = 0xfe
+		binary.LittleEndian.PutUint32(data[1:], uint32(value))
+		buf.Write(data[:5])
+	default:
+		data[0] = 0xff
+		binary.LittleEndian.PutUint64(data[1:], value)
+		buf.Write(data[:9])
+	}
+}
@@ -0,0 +1,122 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+const (
+	// signedMessageElements was signed like Lisk Elements does
+	signedMessageElements = `-----BEGIN LISK SIGNED MESSAGE-----
+-----MESSAGE-----
+Hello Lisk!
+-----PUBLIC KEY-----
+c094ebee7ec0c50ebee32918655e089f6e1a604b83bcaa760293c61e0f18ab6f
+-----SECOND PUBLIC KEY-----
+615843e1112e31ec56256fc558bbc4e55e423d353ffc946053c5dddea3265b1d
+-----SIGNATURE-----
+b7b8ef6724ce2627ba2fbef9a2bfbaf17373ba8acc7e1a755bdc70e1f1267c5d6ae020bc2945de743b9f20cf8ad7b0860ba9c2ce7688bb1d867c92731ce04f07
+-----SECOND SIGNATURE-----
+26d0e42e8acf4ca37921644972b2032fa8052a9f46bf102c4a6e0aeb190366840a616d1a59f06f11c5b3865c3a15dad6757055577d2cdd0578277f69ad3a3500
+-----END LISK SIGNED MESSAGE-----`
+	signedMessagePassphrase = "wagon stock borrow episode laundry kitten salute link globe zero feed marble"
+)
+
+func TestDigestMessage(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"Hello Lisk!", "cf7465d9199698b2a3b42c0d263c68c06abc5d98bf1f40c9668ad44d3e5c01ce"},
+		{strings.Repeat("x", 300), "2ffe766a8d409dc4dcaa49ba20b48ab251b7f5336ad834b7d79baef376c801c6"},
+	}
+
+	for _, test := range tests {
+		if digest := hex.EncodeToString(DigestMessage(test.in)); digest != test.out {
+			t.Errorf("DigestMessage(%v)=%v; want %v", test.in, digest, test.out)
+		}
+	}
+}
+
+func TestSignMessage(t *testing.T) {
+	signed, err := SignMessage("Hello Lisk!", NewPassphraseSigner(signedMessagePassphrase), NewPassphraseSigner("second"))
+	if err != nil {
+		t.Fatalf("SignMessage() returns error: %v", err)
+	}
+
+	if s := signed.String(); s != signedMessageElements {
+		t.Errorf("SignMessage().String()=%v; want %v", s, signedMessageElements)
+	}
+
+	if valid, err := VerifyMessage(signed); !valid || err != nil {
+		t.Errorf("VerifyMessage(SignMessage())=%v,%v; want true,nil", valid, err)
+	}
+
+	signed, err = SignMessage("multiple\nlines", NewPassphraseSigner(signedMessagePassphrase), nil)
+	if err != nil {
+		t.Fatalf("SignMessage() returns error: %v", err)
+	}
+
+	if strings.Contains(signed.String(), "SECOND") {
+		t.Errorf("SignMessage() without second signer prints second signature: %v", signed)
+	}
+
+	parsed, err := ParseSignedMessage(signed.String())
+	if err != nil || parsed.Message != "multiple\nlines" {
+		t.Fatalf("ParseSignedMessage()=%v,%v; want the signed message", parsed, err)
+	}
+
+	if valid, err := VerifyMessage(parsed); !valid || err != nil {
+		t.Errorf("VerifyMessage(ParseSignedMessage())=%v,%v; want true,nil", valid, err)
+	}
+}
+
+func TestVerifyMessage(t *testing.T) {
+	signed, err := ParseSignedMessage(signedMessageElements)
+	if err != nil {
+		t.Fatalf("ParseSignedMessage() returns error: %v", err)
+	}
+
+	if valid, err := VerifyMessage(signed); !valid || err != nil {
+		t.Errorf("VerifyMessage()=%v,%v; want true,nil", valid, err)
+	}
+
+	signed.Message = "Hello Lisk?"
+	if valid, _ := VerifyMessage(signed); valid {
+		t.Error("VerifyMessage(modified message) returns true")
+	}
+
+	signed.Message = "Hello Lisk!"
+	signed.SecondSignature = signed.Signature
+	if valid, _ := VerifyMessage(signed); valid {
+		t.Error("VerifyMessage(invalid second signature) returns true")
+	}
+
+	signed.SecondSignature = nil
+	if _, err := VerifyMessage(signed); err == nil {
+		t.Error("VerifyMessage(missing second signature) does not return an error")
+	}
+}
+
+func TestParseSignedMessageInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		strings.Replace(signedMessageElements, "-----BEGIN LISK SIGNED MESSAGE-----\n", "", 1),
+		strings.Replace(signedMessageElements, "-----END LISK SIGNED MESSAGE-----", "", 1),
+		strings.Replace(signedMessageElements, "-----PUBLIC KEY-----", "-----KEY-----", 1),
+		strings.Replace(signedMessageElements, "\nb7b8", "\nzzb7b8", 1),
+		strings.Replace(signedMessageElements, "-----SIGNATURE-----", "-----SECOND SIGNATURE-----", 1),
+	}
+
+	for i, test := range tests {
+		if signed, err := ParseSignedMessage(test); err == nil {
+			t.Errorf("#%d: ParseSignedMessage()=%v; want error", i, signed)
+		}
+	}
+
+	crlf := strings.Replace(signedMessageElements, "\n", "\r\n", -1)
+	if signed, err := ParseSignedMessage(crlf); err != nil || signed.Message != "Hello Lisk!" {
+		t.Errorf("ParseSignedMessage(CRLF)=%v,%v; want the signed message", signed, err)
+	}
+}
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// Signer signs data on behalf of a Lisk account.
+	// Implementations don't need to hold the private key in process memory.
+	Signer interface {
+		// PublicKey returns the public key belonging to the signing key
+		PublicKey() []byte
+		// Sign signs the given digest and returns the signature
+		Sign(digest []byte) ([]byte, error)
+	}
+
+	// privateKeySigner is a Signer which holds the private key in memory
+	privateKeySigner struct {
+		privateKey ed25519.PrivateKey
+	}
+)
+
+// NewPassphraseSigner returns a Signer that holds the private key derived from the passphrase in memory
+func NewPassphraseSigner(passphrase string) Signer {
+	return &privateKeySigner{privateKey: GetPrivateKeyFromSecret(passphrase)}
+}
+
+// NewPrivateKeySigner returns a Signer that holds the given private key in memory
+func NewPrivateKeySigner(privateKey []byte) Signer {
+	return &privateKeySigner{privateKey: privateKey}
+}
+
+// PublicKey returns the public key belonging to the private key
+func (s *privateKeySigner) PublicKey() []byte {
+	if len(s.privateKey) != ed25519.PrivateKeySize {
+		return nil
+	}
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
+
+// Sign signs the digest with the private key
+func (s *privateKeySigner) Sign(digest []byte) ([]byte, error) {
+	if len(s.privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid private key size")
+	}
+	return SignDataWithPrivateKey(digest, s.privateKey), nil
+}
@@ -0,0 +1,58 @@
+package crypto
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestNewPassphraseSigner(t *testing.T) {
+	signer := NewPassphraseSigner(defaultPassphrase)
+	if val := signer.PublicKey(); !bytes.Equal(val, defaultPublicKey) {
+		t.Errorf("PassphraseSigner.PublicKey()=%v; want %v", val, defaultPublicKey)
+	}
+
+	val, err := signer.Sign([]byte(defaultMessage))
+	if expected := SignDataWithPrivateKey([]byte(defaultMessage), defaultPrivateKey); !bytes.Equal(val, expected) || err != nil {
+		t.Errorf("PassphraseSigner.Sign(%v)=%v,%v; want %v,%v", defaultMessage, val, err, expected, nil)
+	}
+}
+
+func TestNewPrivateKeySigner(t *testing.T) {
+	if val, err := NewPrivateKeySigner(signPrivateKey).Sign([]byte(defaultMessage)); !bytes.Equal(val, defaultSignature) || err != nil {
+		t.Errorf("PrivateKeySigner.Sign(%v)=%v,%v; want %v,%v", defaultMessage, val, err, defaultSignature, nil)
+	}
+
+	signer := NewPrivateKeySigner([]byte{1, 2, 3})
+	if val := signer.PublicKey(); val != nil {
+		t.Errorf("PrivateKeySigner.PublicKey() with invalid key=%v; want %v", val, nil)
+	}
+
+	if val, err := signer.Sign([]byte(defaultMessage)); err == nil {
+		t.Errorf("PrivateKeySigner.Sign() with invalid key=%v,%v; should throw error", val, err)
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() throws error: %v", err)
+	}
+	defer listener.Close()
+
+	go ServeSigner(listener, NewPrivateKeySigner(signPrivateKey))
+
+	signer, err := DialRemoteSigner("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("DialRemoteSigner() throws error: %v", err)
+	}
+	defer signer.Close()
+
+	if val := signer.PublicKey(); !bytes.Equal(val, signPublicKey) {
+		t.Errorf("RemoteSigner.PublicKey()=%v; want %v", val, signPublicKey)
+	}
+
+	if val, err := signer.Sign([]byte(defaultMessage)); !bytes.Equal(val, defaultSignature) || err != nil {
+		t.Errorf("RemoteSigner.Sign(%v)=%v,%v; want %v,%v", defaultMessage, val, err, defaultSignature, nil)
+	}
+}
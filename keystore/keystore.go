@@ -0,0 +1,474 @@
+// Package keystore stores the passphrases of Lisk accounts encrypted on disk.
+//
+// Every account is encrypted with its own password in the format used by Lisk Elements and Lisk Hub. The address
+// and the public keys are stored in clear, so accounts can be listed without a password. An account has to be
+// unlocked before its signers can sign.
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liskascend/lisk-go/crypto"
+)
+
+type (
+	// Keystore holds named accounts in a file
+	Keystore struct {
+		path string
+
+		// Iterations is the number of PBKDF2 iterations used when passphrases are encrypted
+		Iterations int
+
+		mu       sync.Mutex
+		accounts map[string]*Account
+		unlocked map[string]*unlockedAccount
+	}
+
+	// Account is an account of the keystore
+	Account struct {
+		// Name of the account in the keystore
+		Name string `json:"name"`
+		// Address of the account
+		Address string `json:"address"`
+		// PublicKey is the hex encoded public key of the account
+		PublicKey string `json:"publicKey"`
+		// SecondPublicKey is the hex encoded public key of the second passphrase if the account has one
+		SecondPublicKey string `json:"secondPublicKey,omitempty"`
+		// Passphrase is the encrypted passphrase
+		Passphrase *crypto.EncryptedPassphrase `json:"passphrase"`
+		// SecondPassphrase is the encrypted second passphrase if the account has one
+		SecondPassphrase *crypto.EncryptedPassphrase `json:"secondPassphrase,omitempty"`
+	}
+
+	// keystoreFile is the format of the keystore file
+	keystoreFile struct {
+		Version  int        `json:"version"`
+		Accounts []*Account `json:"accounts"`
+	}
+
+	// unlockedAccount holds the private keys of an unlocked account
+	unlockedAccount struct {
+		privateKey       []byte
+		secondPrivateKey []byte
+		timer            *time.Timer
+	}
+
+	// accountSigner signs with an account of the keystore as long as it's unlocked
+	accountSigner struct {
+		keystore  *Keystore
+		name      string
+		publicKey []byte
+		second    bool
+	}
+)
+
+const (
+	// keystoreVersion is the version of the keystore file format
+	keystoreVersion = 1
+)
+
+var (
+	// ErrAccountNotFound is returned if the keystore has no account with the name
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrAccountExists is returned if the keystore already has an account with the name
+	ErrAccountExists = errors.New("account already exists")
+	// ErrLocked is returned if an account is used for signing while it's locked
+	ErrLocked = errors.New("account is locked")
+	// ErrInvalidPassword is returned if an account cannot be decrypted with the password
+	ErrInvalidPassword = errors.New("invalid password")
+)
+
+// Open loads the keystore from the file at path. A new keystore is created if the file does not exist.
+func Open(path string) (*Keystore, error) {
+	k := &Keystore{
+		path:       path,
+		Iterations: crypto.DefaultPBKDF2Iterations,
+		accounts:   make(map[string]*Account),
+		unlocked:   make(map[string]*unlockedAccount),
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return k, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file keystoreFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid keystore file: %v", err)
+	}
+
+	if file.Version != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", file.Version)
+	}
+
+	for _, account := range file.Accounts {
+		if account.Passphrase == nil {
+			return nil, fmt.Errorf("account %s has no passphrase", account.Name)
+		}
+		k.accounts[account.Name] = account
+	}
+
+	return k, nil
+}
+
+// Accounts returns all accounts sorted by name
+func (k *Keystore) Accounts() []*Account {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	accounts := make([]*Account, 0, len(k.accounts))
+	for _, account := range k.accounts {
+		copied := *account
+		accounts = append(accounts, &copied)
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Name < accounts[j].Name })
+	return accounts
+}
+
+// Account returns the account with the name
+func (k *Keystore) Account(name string) (*Account, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	account, ok := k.accounts[name]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	copied := *account
+	return &copied, nil
+}
+
+// Import adds an account with the passphrase encrypted with the password.
+// The second passphrase is optional and only required for lisk wallets with a second signature.
+func (k *Keystore) Import(name, passphrase, secondPassphrase, password string) (*Account, error) {
+	if name == "" {
+		return nil, errors.New("name must not be empty")
+	}
+	if passphrase == "" {
+		return nil, errors.New("passphrase must not be empty")
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, ok := k.accounts[name]; ok {
+		return nil, ErrAccountExists
+	}
+
+	account, err := k.newAccount(name, passphrase, secondPassphrase, password)
+	if err != nil {
+		return nil, err
+	}
+
+	k.accounts[name] = account
+	if err := k.save(); err != nil {
+		delete(k.accounts, name)
+		return nil, err
+	}
+
+	copied := *account
+	return &copied, nil
+}
+
+// Export decrypts the passphrases of the account with the password.
+// The second passphrase is empty if the account has none.
+func (k *Keystore) Export(name, password string) (passphrase, secondPassphrase string, err error) {
+	account, err := k.Account(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return account.decrypt(password)
+}
+
+// Remove removes the account from the keystore
+func (k *Keystore) Remove(name string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	account, ok := k.accounts[name]
+	if !ok {
+		return ErrAccountNotFound
+	}
+
+	delete(k.accounts, name)
+	if err := k.save(); err != nil {
+		k.accounts[name] = account
+		return err
+	}
+
+	k.lock(name)
+	return nil
+}
+
+// ChangePassword encrypts the passphrases of the account with a new password
+func (k *Keystore) ChangePassword(name, password, newPassword string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	account, ok := k.accounts[name]
+	if !ok {
+		return ErrAccountNotFound
+	}
+
+	passphrase, secondPassphrase, err := account.decrypt(password)
+	if err != nil {
+		return err
+	}
+
+	changed, err := k.newAccount(name, passphrase, secondPassphrase, newPassword)
+	if err != nil {
+		return err
+	}
+
+	k.accounts[name] = changed
+	if err := k.save(); err != nil {
+		k.accounts[name] = account
+		return err
+	}
+
+	return nil
+}
+
+// Unlock decrypts the account with the password so its signers can sign.
+// The account is locked again after the timeout. It stays unlocked until Lock is called if the timeout is 0.
+func (k *Keystore) Unlock(name, password string, timeout time.Duration) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	account, ok := k.accounts[name]
+	if !ok {
+		return ErrAccountNotFound
+	}
+
+	passphrase, secondPassphrase, err := account.decrypt(password)
+	if err != nil {
+		return err
+	}
+
+	k.lock(name)
+
+	unlocked := &unlockedAccount{privateKey: crypto.GetPrivateKeyFromSecret(passphrase)}
+	if secondPassphrase != "" {
+		unlocked.secondPrivateKey = crypto.GetPrivateKeyFromSecret(secondPassphrase)
+	}
+
+	if timeout > 0 {
+		unlocked.timer = time.AfterFunc(timeout, func() {
+			k.mu.Lock()
+			defer k.mu.Unlock()
+
+			// The account may have been locked and unlocked again in the meantime
+			if k.unlocked[name] == unlocked {
+				k.lock(name)
+			}
+		})
+	}
+
+	k.unlocked[name] = unlocked
+	return nil
+}
+
+// Lock removes the decrypted keys of the account from memory
+func (k *Keystore) Lock(name string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.lock(name)
+}
+
+// LockAll locks all accounts
+func (k *Keystore) LockAll() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for name := range k.unlocked {
+		k.lock(name)
+	}
+}
+
+// IsUnlocked returns whether the account is unlocked
+func (k *Keystore) IsUnlocked(name string) bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	_, ok := k.unlocked[name]
+	return ok
+}
+
+// Signers returns the signers of the account. The second signer is nil if the account has no second passphrase.
+// The signers can be retrieved while the account is locked but only sign while it's unlocked.
+func (k *Keystore) Signers(name string) (signer crypto.Signer, secondSigner crypto.Signer, err error) {
+	account, err := k.Account(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := hex.DecodeString(account.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid public key of account %s: %v", name, err)
+	}
+	signer = &accountSigner{keystore: k, name: name, publicKey: publicKey}
+
+	if account.SecondPublicKey != "" {
+		secondPublicKey, err := hex.DecodeString(account.SecondPublicKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid second public key of account %s: %v", name, err)
+		}
+		secondSigner = &accountSigner{keystore: k, name: name, publicKey: secondPublicKey, second: true}
+	}
+
+	return signer, secondSigner, nil
+}
+
+// PublicKey returns the public key of the account
+func (s *accountSigner) PublicKey() []byte {
+	return s.publicKey
+}
+
+// Sign signs the digest if the account is unlocked
+func (s *accountSigner) Sign(digest []byte) ([]byte, error) {
+	s.keystore.mu.Lock()
+	defer s.keystore.mu.Unlock()
+
+	unlocked, ok := s.keystore.unlocked[s.name]
+	if !ok {
+		return nil, ErrLocked
+	}
+
+	privateKey := unlocked.privateKey
+	if s.second {
+		privateKey = unlocked.secondPrivateKey
+	}
+
+	// The account may have been replaced in the meantime
+	signer := crypto.NewPrivateKeySigner(privateKey)
+	if !bytes.Equal(signer.PublicKey(), s.publicKey) {
+		return nil, ErrLocked
+	}
+
+	return signer.Sign(digest)
+}
+
+// newAccount encrypts the passphrases of a new account with the password
+func (k *Keystore) newAccount(name, passphrase, secondPassphrase, password string) (*Account, error) {
+	publicKey := crypto.GetPublicKeyFromSecret(passphrase)
+
+	account := &Account{
+		Name:      name,
+		Address:   crypto.GetAddressFromPublicKey(publicKey),
+		PublicKey: hex.EncodeToString(publicKey),
+	}
+
+	var err error
+	if account.Passphrase, err = crypto.EncryptPassphrase(passphrase, password, k.Iterations); err != nil {
+		return nil, err
+	}
+
+	if secondPassphrase != "" {
+		account.SecondPublicKey = hex.EncodeToString(crypto.GetPublicKeyFromSecret(secondPassphrase))
+		if account.SecondPassphrase, err = crypto.EncryptPassphrase(secondPassphrase, password,
+			k.Iterations); err != nil {
+			return nil, err
+		}
+	}
+
+	return account, nil
+}
+
+// decrypt decrypts the passphrases of the account and checks them against the public keys
+func (a *Account) decrypt(password string) (passphrase, secondPassphrase string, err error) {
+	if passphrase, err = a.Passphrase.Decrypt(password); err != nil {
+		return "", "", ErrInvalidPassword
+	}
+
+	if hex.EncodeToString(crypto.GetPublicKeyFromSecret(passphrase)) != a.PublicKey {
+		return "", "", fmt.Errorf("passphrase of account %s does not match its public key", a.Name)
+	}
+
+	if a.SecondPassphrase != nil {
+		if secondPassphrase, err = a.SecondPassphrase.Decrypt(password); err != nil {
+			return "", "", ErrInvalidPassword
+		}
+
+		if hex.EncodeToString(crypto.GetPublicKeyFromSecret(secondPassphrase)) != a.SecondPublicKey {
+			return "", "", fmt.Errorf("second passphrase of account %s does not match its public key", a.Name)
+		}
+	}
+
+	return passphrase, secondPassphrase, nil
+}
+
+// lock removes the keys of the account from memory. The mutex must be held.
+func (k *Keystore) lock(name string) {
+	unlocked, ok := k.unlocked[name]
+	if !ok {
+		return
+	}
+
+	if unlocked.timer != nil {
+		unlocked.timer.Stop()
+	}
+
+	zero(unlocked.privateKey)
+	zero(unlocked.secondPrivateKey)
+	delete(k.unlocked, name)
+}
+
+// save writes the keystore to a temporary file and renames it, so the file is never left partially written.
+// The mutex must be held.
+func (k *Keystore) save() error {
+	file := keystoreFile{Version: keystoreVersion}
+	for _, account := range k.accounts {
+		file.Accounts = append(file.Accounts, account)
+	}
+	sort.Slice(file.Accounts, func(i, j int) bool { return file.Accounts[i].Name < file.Accounts[j].Name })
+
+	data, err := json.MarshalIndent(&file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(k.path), filepath.Base(k.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	// TempFile creates the file readable by the owner only
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), k.path)
+}
+
+// zero overwrites the key with zeros
+func zero(key []byte) {
+	for i := range key {
+		key[i] = 0
+	}
+}
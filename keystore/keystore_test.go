@@ -0,0 +1,175 @@
+package keystore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/crypto"
+)
+
+const (
+	testPassphrase       = "wagon stock borrow episode laundry kitten salute link globe zero feed marble"
+	testSecondPassphrase = "second"
+	testPassword         = "password"
+)
+
+func openTestKeystore(t *testing.T) (*Keystore, string, func()) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "keystore.json")
+	k, err := Open(path)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("Open() returns error: %v", err)
+	}
+	k.Iterations = 10
+
+	return k, path, func() { os.RemoveAll(dir) }
+}
+
+func TestKeystore_ImportExport(t *testing.T) {
+	k, path, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	account, err := k.Import("pool", testPassphrase, testSecondPassphrase, testPassword)
+	if err != nil {
+		t.Fatalf("Keystore.Import() returns error: %v", err)
+	}
+
+	wantAddress := crypto.GetAddressFromPublicKey(crypto.GetPublicKeyFromSecret(testPassphrase))
+	if account.Address != wantAddress || account.SecondPublicKey == "" || account.SecondPassphrase == nil {
+		t.Errorf("Keystore.Import() returns %+v", account)
+	}
+
+	if _, err := k.Import("pool", testPassphrase, "", testPassword); err != ErrAccountExists {
+		t.Errorf("Keystore.Import(existing name) returns %v; want %v", err, ErrAccountExists)
+	}
+	if _, err := k.Import("other", testPassphrase, "", testPassword); err != nil {
+		t.Errorf("Keystore.Import() returns error: %v", err)
+	}
+
+	// The accounts are persisted
+	k, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() returns error: %v", err)
+	}
+
+	accounts := k.Accounts()
+	if len(accounts) != 2 || accounts[0].Name != "other" || accounts[1].Name != "pool" {
+		t.Fatalf("Keystore.Accounts() returns %v; want other and pool", accounts)
+	}
+
+	passphrase, secondPassphrase, err := k.Export("pool", testPassword)
+	if err != nil || passphrase != testPassphrase || secondPassphrase != testSecondPassphrase {
+		t.Errorf("Keystore.Export()=%v,%v,%v; want the imported passphrases", passphrase, secondPassphrase, err)
+	}
+
+	if _, _, err := k.Export("pool", "wrong"); err != ErrInvalidPassword {
+		t.Errorf("Keystore.Export(wrong password) returns %v; want %v", err, ErrInvalidPassword)
+	}
+
+	if err := k.Remove("other"); err != nil {
+		t.Errorf("Keystore.Remove() returns error: %v", err)
+	}
+	if _, err := k.Account("other"); err != ErrAccountNotFound {
+		t.Errorf("Keystore.Account(removed) returns %v; want %v", err, ErrAccountNotFound)
+	}
+}
+
+func TestKeystore_UnlockLock(t *testing.T) {
+	k, _, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	if _, err := k.Import("pool", testPassphrase, testSecondPassphrase, testPassword); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, secondSigner, err := k.Signers("pool")
+	if err != nil || signer == nil || secondSigner == nil {
+		t.Fatalf("Keystore.Signers() returns %v,%v,%v", signer, secondSigner, err)
+	}
+
+	if _, err := signer.Sign([]byte("digest")); err != ErrLocked {
+		t.Errorf("Signer.Sign() of locked account returns %v; want %v", err, ErrLocked)
+	}
+
+	if err := k.Unlock("pool", "wrong", 0); err != ErrInvalidPassword {
+		t.Errorf("Keystore.Unlock(wrong password) returns %v; want %v", err, ErrInvalidPassword)
+	}
+
+	if err := k.Unlock("pool", testPassword, 0); err != nil {
+		t.Fatalf("Keystore.Unlock() returns error: %v", err)
+	}
+
+	want, _ := crypto.NewPassphraseSigner(testSecondPassphrase).Sign([]byte("digest"))
+	if signature, err := secondSigner.Sign([]byte("digest")); err != nil || string(signature) != string(want) {
+		t.Errorf("Signer.Sign() of unlocked account returns %x,%v; want %x", signature, err, want)
+	}
+
+	k.Lock("pool")
+	if _, err := signer.Sign([]byte("digest")); err != ErrLocked || k.IsUnlocked("pool") {
+		t.Errorf("Signer.Sign() after Lock returns %v; want %v", err, ErrLocked)
+	}
+
+	if err := k.Unlock("pool", testPassword, 10*time.Millisecond); err != nil {
+		t.Fatalf("Keystore.Unlock() returns error: %v", err)
+	}
+	if _, err := signer.Sign([]byte("digest")); err != nil {
+		t.Errorf("Signer.Sign() before the timeout returns error: %v", err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if _, err := signer.Sign([]byte("digest")); err != ErrLocked {
+		t.Errorf("Signer.Sign() after the timeout returns %v; want %v", err, ErrLocked)
+	}
+}
+
+func TestKeystore_ChangePassword(t *testing.T) {
+	k, path, cleanup := openTestKeystore(t)
+	defer cleanup()
+
+	if _, err := k.Import("pool", testPassphrase, "", testPassword); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := k.ChangePassword("pool", "wrong", "new"); err != ErrInvalidPassword {
+		t.Errorf("Keystore.ChangePassword(wrong password) returns %v; want %v", err, ErrInvalidPassword)
+	}
+
+	if err := k.ChangePassword("pool", testPassword, "new"); err != nil {
+		t.Fatalf("Keystore.ChangePassword() returns error: %v", err)
+	}
+
+	k, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := k.Unlock("pool", testPassword, 0); err != ErrInvalidPassword {
+		t.Errorf("Keystore.Unlock(old password) returns %v; want %v", err, ErrInvalidPassword)
+	}
+	if err := k.Unlock("pool", "new", 0); err != nil {
+		t.Errorf("Keystore.Unlock(new password) returns error: %v", err)
+	}
+}
+
+func TestOpenInvalidVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "keystore.json")
+	ioutil.WriteFile(path, []byte(`{"version":2,"accounts":[]}`), 0600)
+
+	if _, err := Open(path); err == nil {
+		t.Error("Open() of unsupported version does not return an error")
+	}
+}
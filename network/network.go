@@ -0,0 +1,174 @@
+// Package network contains the definitions of the Lisk networks.
+// A network specifies the nethash, the epoch of its blockchain time, the transaction fees and the nodes
+// that can be used to connect to it.
+package network
+
+import (
+	"time"
+)
+
+type (
+	// Network is a Lisk network
+	Network struct {
+		// Name is a human readable name of the network
+		Name string
+		// Nethash is the hash of the genesis block which identifies the network
+		Nethash string
+		// Epoch is the start of the blockchain time of the network
+		Epoch time.Time
+		// Fees are the transaction fees of the network. DefaultFees are used if it's nil.
+		Fees *FeeSchedule
+		// FeeProvider provides the current fees of the network. Fees are used as fallback if it's nil or fails.
+		FeeProvider FeeProvider
+		// SeedNodes are nodes with a public API that can be used to connect to the network
+		SeedNodes []Node
+	}
+
+	// FeeProvider provides the current fee schedule of a network, e.g. from the constants of a node
+	FeeProvider interface {
+		// FeeSchedule returns the current fee schedule. It's called whenever the fee of a transaction is computed
+		// and must not block, e.g. on a request, so the schedule has to be fetched in advance.
+		FeeSchedule() (*FeeSchedule, error)
+	}
+
+	// Node is a Lisk node with a public API
+	Node struct {
+		// Hostname is the hostname/IP of the Lisk Node to connect to.
+		Hostname string
+		// Port is the port used by the Lisk node.
+		Port int
+		// Secure specifies whether https should be used.
+		Secure bool
+	}
+
+	// FeeSchedule are the fees of the transaction types in beddows (1 LSK = 10^8 beddows)
+	FeeSchedule struct {
+		// Send is the fee for a send transaction
+		Send uint64
+		// Data is the additional fee for a send transaction with data
+		Data uint64
+		// SecondSignature is the fee for a second signature registration transaction
+		SecondSignature uint64
+		// Delegate is the fee for a delegate registration transaction
+		Delegate uint64
+		// Vote is the fee for a vote transaction
+		Vote uint64
+		// Multisignature is the fee per key for a multisignature creation/update transaction
+		Multisignature uint64
+		// DappRegistration is the fee for a dapp creation transaction
+		DappRegistration uint64
+		// DappDeposit is the fee for a dapp deposit (in transfer) transaction
+		DappDeposit uint64
+		// DappWithdrawal is the fee for a dapp withdrawal (out transfer) transaction
+		DappWithdrawal uint64
+	}
+)
+
+const (
+	// FixedPoint is the number of beddows in 1 LSK
+	FixedPoint = 100000000
+)
+
+var (
+	// DefaultEpoch is the start of the blockchain time of the public Lisk networks
+	DefaultEpoch = time.Date(2016, 5, 24, 17, 0, 0, 0, time.UTC)
+
+	// DefaultFees are the transaction fees of the public Lisk networks
+	DefaultFees = &FeeSchedule{
+		Send:             0.1 * FixedPoint,
+		Data:             0.1 * FixedPoint,
+		SecondSignature:  5 * FixedPoint,
+		Delegate:         25 * FixedPoint,
+		Vote:             1 * FixedPoint,
+		Multisignature:   5 * FixedPoint,
+		DappRegistration: 25 * FixedPoint,
+		DappDeposit:      0.1 * FixedPoint,
+		DappWithdrawal:   0.1 * FixedPoint,
+	}
+
+	// Mainnet is the Lisk main network
+	Mainnet = &Network{
+		Name:    "mainnet",
+		Nethash: "ed14889723f24ecc54871d058d98ce91ff2f973192075c0155ba2b7b70ad2511",
+		Epoch:   DefaultEpoch,
+		Fees:    DefaultFees,
+		SeedNodes: []Node{
+			{"hub21.lisk.io", 443, true},
+			{"hub22.lisk.io", 443, true},
+			{"hub23.lisk.io", 443, true},
+			{"hub24.lisk.io", 443, true},
+			{"hub25.lisk.io", 443, true},
+			{"hub26.lisk.io", 443, true},
+			{"hub27.lisk.io", 443, true},
+			{"hub28.lisk.io", 443, true},
+		},
+	}
+
+	// Testnet is the Lisk test network
+	Testnet = &Network{
+		Name:      "testnet",
+		Nethash:   "da3ed6a45429278bac2666961289ca17ad86595d33b31037615d4b8e8f158bba",
+		Epoch:     DefaultEpoch,
+		Fees:      DefaultFees,
+		SeedNodes: []Node{{"testnet.lisk.io", 443, true}},
+	}
+
+	// Betanet is the Lisk beta network
+	Betanet = &Network{
+		Name:      "betanet",
+		Nethash:   "ef3844327d1fd0fc5785291806150c937797bdb34a748c9cd932b7e859e9ca0c",
+		Epoch:     DefaultEpoch,
+		Fees:      DefaultFees,
+		SeedNodes: []Node{{"betanet.lisk.io", 5000, false}},
+	}
+)
+
+// NewCustomNetwork returns a network with the given parameters, e.g. for a private devnet.
+// It uses the DefaultFees.
+func NewCustomNetwork(name, nethash string, epoch time.Time, seedNodes []Node) *Network {
+	return &Network{
+		Name:      name,
+		Nethash:   nethash,
+		Epoch:     epoch,
+		Fees:      DefaultFees,
+		SeedNodes: seedNodes,
+	}
+}
+
+// GetFees returns the fee schedule of the network.
+// The cached schedule of the FeeProvider is used if it's set and available, otherwise the Fees of the network.
+func (n *Network) GetFees() *FeeSchedule {
+	if n.FeeProvider != nil {
+		if fees, err := n.FeeProvider.FeeSchedule(); err == nil && fees != nil {
+			return fees
+		}
+	}
+
+	if n.Fees == nil {
+		return DefaultFees
+	}
+	return n.Fees
+}
+
+// WithFeeProvider returns a copy of the network that uses the given provider for its fees
+func (n *Network) WithFeeProvider(provider FeeProvider) *Network {
+	network := *n
+	network.FeeProvider = provider
+
+	return &network
+}
+
+// GetTimestamp returns the blockchain time of the network at the given time in seconds since the epoch
+func (n *Network) GetTimestamp(t time.Time) uint32 {
+	return uint32(t.Sub(n.Epoch) / time.Second)
+}
+
+// GetTime returns the time of the given blockchain timestamp of the network
+func (n *Network) GetTime(timestamp uint32) time.Time {
+	return n.Epoch.Add(time.Duration(timestamp) * time.Second)
+}
+
+// IsSameNetwork returns whether both networks have the same nethash
+func (n *Network) IsSameNetwork(other *Network) bool {
+	return other != nil && n.Nethash == other.Nethash
+}
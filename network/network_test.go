@@ -0,0 +1,68 @@
+package network
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type staticFeeProvider struct {
+	fees *FeeSchedule
+	err  error
+}
+
+func (p *staticFeeProvider) FeeSchedule() (*FeeSchedule, error) {
+	return p.fees, p.err
+}
+
+var (
+	defaultTime      = time.Date(2016, 5, 24, 17, 0, 20, 0, time.UTC)
+	defaultTimestamp = uint32(20)
+)
+
+func TestNetwork_GetTimestamp(t *testing.T) {
+	if val := Mainnet.GetTimestamp(defaultTime); val != defaultTimestamp {
+		t.Errorf("Network.GetTimestamp(%v)=%v; want %v", defaultTime, val, defaultTimestamp)
+	}
+
+	devnet := NewCustomNetwork("devnet", "", DefaultEpoch.Add(10*time.Second), nil)
+	if val := devnet.GetTimestamp(defaultTime); val != defaultTimestamp-10 {
+		t.Errorf("Network.GetTimestamp(%v)=%v; want %v", defaultTime, val, defaultTimestamp-10)
+	}
+}
+
+func TestNetwork_GetTime(t *testing.T) {
+	if val := Mainnet.GetTime(defaultTimestamp); !val.Equal(defaultTime) {
+		t.Errorf("Network.GetTime(%v)=%v; want %v", defaultTimestamp, val, defaultTime)
+	}
+}
+
+func TestNetwork_GetFees(t *testing.T) {
+	if val := (&Network{}).GetFees(); val != DefaultFees {
+		t.Errorf("Network.GetFees()=%v; want %v", val, DefaultFees)
+	}
+}
+
+func TestNetwork_WithFeeProvider(t *testing.T) {
+	fees := &FeeSchedule{Send: 1}
+
+	network := Testnet.WithFeeProvider(&staticFeeProvider{fees: fees})
+	if val := network.GetFees(); val != fees {
+		t.Errorf("Network.GetFees() with provider=%v; want %v", val, fees)
+	}
+
+	if Testnet.FeeProvider != nil {
+		t.Errorf("Network.WithFeeProvider() modifies the original network")
+	}
+
+	network = Testnet.WithFeeProvider(&staticFeeProvider{err: errors.New("unavailable")})
+	if val := network.GetFees(); val != Testnet.Fees {
+		t.Errorf("Network.GetFees() with failing provider=%v; want %v", val, Testnet.Fees)
+	}
+}
+
+func TestNetwork_IsSameNetwork(t *testing.T) {
+	if Mainnet.IsSameNetwork(Testnet) || !Testnet.IsSameNetwork(Testnet) || Mainnet.IsSameNetwork(nil) {
+		t.Errorf("Network.IsSameNetwork() returns wrong data")
+	}
+}
@@ -0,0 +1,200 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/liskascend/lisk-go/api"
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+// Engine computes and pays the payouts of a delegate
+type Engine struct {
+	client *api.Client
+	ledger *Ledger
+
+	// Delegate is the address of the delegate
+	Delegate string
+	// Rules configure the payouts
+	Rules *Rules
+	// Data is attached to the payout transactions if set, e.g. the name of the pool
+	Data string
+}
+
+// NewEngine returns an engine for the delegate with the given address that records the payouts in the ledger
+func NewEngine(client *api.Client, ledger *Ledger, delegate string, rules *Rules) *Engine {
+	return &Engine{
+		client:   client,
+		ledger:   ledger,
+		Delegate: delegate,
+		Rules:    rules,
+	}
+}
+
+// Plan computes the payouts for the period without sending them and can be used as a dry run.
+// The voters are weighted by their current balance. If a payout run of the period has already been started, the
+// report of that run is returned, so an interrupted run is resumed with the same payouts. Periods that overlap a
+// recorded period can't be planned and no other period can be planned until the started run is completed.
+func (e *Engine) Plan(ctx context.Context, from, to time.Time) (*Report, error) {
+	if err := e.Rules.isValid(); err != nil {
+		return nil, err
+	}
+
+	if !from.Before(to) {
+		return nil, errors.New("period must end after it starts")
+	}
+
+	if report := e.ledger.report(periodID(from, to)); report != nil {
+		return report, nil
+	}
+
+	if err := e.ledger.checkPeriod(from, to); err != nil {
+		return nil, err
+	}
+
+	stats, err := e.client.GetForgingStats(ctx, &api.ForgingStatsRequest{
+		Address:       e.Delegate,
+		FromTimestamp: toMillis(from),
+		ToTimestamp:   toMillis(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	forged, err := e.Rules.forgedAmount(&stats.Stats)
+	if err != nil {
+		return nil, err
+	}
+
+	var voters []*api.Voter
+	it := e.client.IterateDelegateVoters(ctx, &api.DelegateVoterRequest{Address: e.Delegate})
+	for it.Next() {
+		voters = append(voters, it.Voter())
+	}
+	it.Close()
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	report := computeReport(e.Rules, forged, voters, e.ledger.pending())
+	report.From, report.To = from, to
+
+	return report, nil
+}
+
+// Pay signs and sends the payouts of the report using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
+// Every transaction is recorded in the ledger before it is sent. If Pay fails, it can be called again with the
+// report returned by Plan for the same period. Payouts that have already been sent are skipped and recorded
+// transactions are sent again instead of creating new ones. Like Plan, Pay fails for periods that overlap a recorded
+// period or while the run of another period is not completed.
+func (e *Engine) Pay(ctx context.Context, report *Report, signer crypto.Signer, secondSigner crypto.Signer) error {
+	net := e.client.Network()
+	if net == nil {
+		return errors.New("client is not bound to a network")
+	}
+
+	if signer == nil {
+		return errors.New("signer must not be nil")
+	}
+
+	if address := crypto.GetAddressFromPublicKey(signer.PublicKey()); address != e.Delegate {
+		return fmt.Errorf("signer %s is not the delegate %s", address, e.Delegate)
+	}
+
+	report, err := e.ledger.begin(report)
+	if err != nil {
+		return err
+	}
+	id := report.ID()
+
+	for _, entry := range report.Entries {
+		if entry.Amount == 0 {
+			continue
+		}
+
+		record := e.ledger.payout(id, entry.Address)
+		resumed := record != nil
+
+		if record == nil {
+			record, err = e.newPayout(entry, signer, secondSigner)
+			if err != nil {
+				return err
+			}
+
+			if err := e.ledger.recordPayout(id, entry.Address, record); err != nil {
+				return err
+			}
+		}
+
+		if record.Sent {
+			continue
+		}
+
+		if resumed {
+			// The transaction may have been sent before the previous run was interrupted
+			status, err := e.client.GetTransactionStatus(ctx, record.TransactionID)
+			if err != nil {
+				return err
+			}
+
+			if status.Status != api.TransactionStatusUnknown && status.Status != api.TransactionStatusDropped &&
+				status.Status != api.TransactionStatusExpired {
+				if err := e.ledger.markSent(id, entry.Address); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		transaction := &transactions.Transaction{}
+		if err := json.Unmarshal(record.Transaction, transaction); err != nil {
+			return fmt.Errorf("invalid payout transaction of %s in ledger: %v", entry.Address, err)
+		}
+		transaction.Network = net
+
+		if _, err := e.client.SendTransaction(ctx, transaction); err != nil {
+			return fmt.Errorf("could not send payout to %s: %v", entry.Address, err)
+		}
+
+		if err := e.ledger.markSent(id, entry.Address); err != nil {
+			return err
+		}
+	}
+
+	return e.ledger.complete(id)
+}
+
+// newPayout creates the signed payout transaction of the entry
+func (e *Engine) newPayout(entry *Entry, signer crypto.Signer, secondSigner crypto.Signer) (*payoutRecord, error) {
+	var transaction *transactions.Transaction
+	var err error
+
+	if e.Data != "" {
+		transaction, err = transactions.NewTransactionWithData(e.client.Network(), entry.Address, entry.Amount, signer,
+			secondSigner, 0, e.Data)
+	} else {
+		transaction, err = transactions.NewTransaction(e.client.Network(), entry.Address, entry.Amount, signer,
+			secondSigner, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := transaction.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payoutRecord{TransactionID: transactionID, Transaction: data}, nil
+}
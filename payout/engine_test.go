@@ -0,0 +1,262 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/api"
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+)
+
+var (
+	testDelegateSigner  = crypto.NewPassphraseSigner("delegate")
+	testDelegateAddress = crypto.GetAddressFromPublicKey(testDelegateSigner.PublicKey())
+)
+
+// testNode is a node stand-in that serves the forging statistics and voters of the delegate and accepts payouts
+type testNode struct {
+	mu sync.Mutex
+	// statsRequests is the number of forging statistics requests
+	statsRequests int
+	// pool are the recipients of the accepted transactions by transaction ID
+	pool map[string]string
+	// sent are the IDs of the transactions sent to each recipient
+	sent map[string][]string
+	// failures makes the next send to a recipient fail. The transaction is accepted anyway if it is "lost".
+	failures map[string]string
+}
+
+func newTestNode() *testNode {
+	return &testNode{
+		pool:     make(map[string]string),
+		sent:     make(map[string][]string),
+		failures: make(map[string]string),
+	}
+}
+
+// respond writes the data or an error response if status is not 200
+func respond(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if status != http.StatusOK {
+		json.NewEncoder(w).Encode(map[string]interface{}{"message": http.StatusText(status)})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func (n *testNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/api/delegates/"+testDelegateAddress+"/forging_statistics":
+		n.statsRequests++
+		respond(w, &api.ForgingStats{Rewards: "1000", Fees: "0", Forged: "1000", Count: "2"}, http.StatusOK)
+
+	case r.URL.Path == "/api/voters":
+		voters := []*api.Voter{}
+		if offset := r.URL.Query().Get("offset"); offset == "" || offset == "0" {
+			voters = []*api.Voter{{Address: "1L", Balance: 2}, {Address: "2L", Balance: 3}, {Address: "3L", Balance: 5}}
+		}
+		respond(w, &api.DelegateWithVoters{Address: testDelegateAddress, Voters: voters}, http.StatusOK)
+
+	case r.URL.Path == "/api/transactions" && r.Method == http.MethodPost:
+		transaction := &struct {
+			ID          string `json:"id"`
+			RecipientID string `json:"recipientId"`
+		}{}
+		json.NewDecoder(r.Body).Decode(transaction)
+		n.sent[transaction.RecipientID] = append(n.sent[transaction.RecipientID], transaction.ID)
+
+		failure := n.failures[transaction.RecipientID]
+		delete(n.failures, transaction.RecipientID)
+
+		if failure != "rejected" {
+			n.pool[transaction.ID] = transaction.RecipientID
+		}
+		if failure != "" {
+			respond(w, nil, http.StatusBadRequest)
+			return
+		}
+		respond(w, map[string]string{"message": "Transaction(s) accepted"}, http.StatusOK)
+
+	case r.URL.Path == "/api/transactions":
+		respond(w, []*api.Transaction{}, http.StatusOK)
+
+	case r.URL.Path == "/api/node/transactions/unconfirmed":
+		id := r.URL.Query().Get("id")
+		if _, ok := n.pool[id]; ok {
+			respond(w, []*api.Transaction{{ID: id}}, http.StatusOK)
+			return
+		}
+		respond(w, []*api.Transaction{}, http.StatusOK)
+
+	case strings.HasPrefix(r.URL.Path, "/api/node/transactions/"):
+		respond(w, []*api.Transaction{}, http.StatusOK)
+
+	default:
+		respond(w, nil, http.StatusNotFound)
+	}
+}
+
+// testEngine returns an engine of the delegate that uses the node and the ledger at path
+func testEngine(t *testing.T, server *httptest.Server, path string) *Engine {
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+
+	client := api.NewClientWithCustomConfig(&api.Config{
+		Network: network.Testnet,
+		Host:    api.Host{Hostname: serverURL.Hostname(), Port: port},
+		Timeout: time.Second,
+	})
+
+	ledger, err := OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() returns error: %v", err)
+	}
+
+	return NewEngine(client, ledger, testDelegateAddress, &Rules{Percentage: 100})
+}
+
+func TestEngine_PayResumesInterruptedRun(t *testing.T) {
+	tests := []struct {
+		// failure of the payout to 2L in the first run
+		failure string
+		// sends is the number of times the payout to 2L is sent
+		sends int
+	}{
+		{failure: "rejected", sends: 2},
+		{failure: "lost", sends: 1},
+	}
+
+	from, to := time.Unix(1000, 0), time.Unix(2000, 0)
+
+	for _, test := range tests {
+		dir, err := ioutil.TempDir("", "engine")
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, "ledger.json")
+
+		node := newTestNode()
+		node.failures["2L"] = test.failure
+		server := httptest.NewServer(node)
+
+		engine := testEngine(t, server, path)
+		report, err := engine.Plan(context.Background(), from, to)
+		if err != nil {
+			t.Fatalf("%s: Engine.Plan() returns error: %v", test.failure, err)
+		}
+		if err := engine.Pay(context.Background(), report, testDelegateSigner, nil); err == nil {
+			t.Errorf("%s: Engine.Pay() returns no error for a failed payout; want error", test.failure)
+		}
+
+		// The run is resumed after a restart with the report of the ledger
+		engine = testEngine(t, server, path)
+		resumed, err := engine.Plan(context.Background(), from, to)
+		if err != nil || resumed.ID() != report.ID() || resumed.Total() != report.Total() {
+			t.Fatalf("%s: Engine.Plan() returns %v,%v; want the started report", test.failure, resumed, err)
+		}
+		if err := engine.Pay(context.Background(), resumed, testDelegateSigner, nil); err != nil {
+			t.Fatalf("%s: Engine.Pay() returns error: %v", test.failure, err)
+		}
+
+		if node.statsRequests != 1 {
+			t.Errorf("%s: Engine.Plan() requested the forging statistics %d times; want 1", test.failure,
+				node.statsRequests)
+		}
+		if len(node.sent["3L"]) != 1 || len(node.sent["1L"]) != 1 {
+			t.Errorf("%s: Engine.Pay() sent %v; want one payout to 3L and 1L", test.failure, node.sent)
+		}
+		if sent := node.sent["2L"]; len(sent) != test.sends || sent[0] != sent[len(sent)-1] {
+			t.Errorf("%s: Engine.Pay() sent %v to 2L; want the recorded transaction %d times", test.failure, sent,
+				test.sends)
+		}
+		if !engine.ledger.IsCompleted(from, to) {
+			t.Errorf("%s: Engine.Pay() does not complete the period", test.failure)
+		}
+
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestEngine_RejectsOverlappingPeriods(t *testing.T) {
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	node := newTestNode()
+	server := httptest.NewServer(node)
+	defer server.Close()
+
+	engine := testEngine(t, server, filepath.Join(dir, "ledger.json"))
+	ctx := context.Background()
+
+	pay := func(from, to int64) error {
+		report, err := engine.Plan(ctx, time.Unix(from, 0), time.Unix(to, 0))
+		if err != nil {
+			return err
+		}
+		return engine.Pay(ctx, report, testDelegateSigner, nil)
+	}
+
+	if err := pay(1000, 2000); err != nil {
+		t.Fatalf("Engine.Pay() returns error: %v", err)
+	}
+
+	for _, period := range [][2]int64{{1500, 2500}, {500, 1500}, {1200, 1800}, {0, 3000}} {
+		if report, err := engine.Plan(ctx, time.Unix(period[0], 0), time.Unix(period[1], 0)); err == nil {
+			t.Errorf("Engine.Plan() returns %v,nil for period %v overlapping the paid period; want error", report,
+				period)
+		}
+	}
+
+	// Reports of overlapping periods are not paid
+	overlapping := computeReport(engine.Rules, 1000, []*api.Voter{{Address: "1L", Balance: 1}}, nil)
+	overlapping.From, overlapping.To = time.Unix(1500, 0), time.Unix(2500, 0)
+	if err := engine.Pay(ctx, overlapping, testDelegateSigner, nil); err == nil {
+		t.Error("Engine.Pay() returns no error for a period overlapping the paid period; want error")
+	}
+	if sent := len(node.sent["1L"]); sent != 1 {
+		t.Errorf("Engine.Pay() sent %d payouts to 1L; want 1", sent)
+	}
+
+	// An interrupted run blocks other periods until it is completed
+	node.failures["3L"] = "rejected"
+	if err := pay(2000, 3000); err == nil {
+		t.Fatal("Engine.Pay() returns no error for a failed payout; want error")
+	}
+
+	if report, err := engine.Plan(ctx, time.Unix(3000, 0), time.Unix(4000, 0)); err == nil {
+		t.Errorf("Engine.Plan() returns %v,nil while another run is not completed; want error", report)
+	}
+
+	next := computeReport(engine.Rules, 1000, []*api.Voter{{Address: "1L", Balance: 1}}, nil)
+	next.From, next.To = time.Unix(3000, 0), time.Unix(4000, 0)
+	if err := engine.Pay(ctx, next, testDelegateSigner, nil); err == nil {
+		t.Error("Engine.Pay() returns no error while another run is not completed; want error")
+	}
+
+	if err := pay(2000, 3000); err != nil {
+		t.Fatalf("Engine.Pay() returns error for the resumed run: %v", err)
+	}
+	if err := pay(3000, 4000); err != nil {
+		t.Errorf("Engine.Pay() returns error after the previous run was completed: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package payout
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type (
+	// Ledger records the payouts in a JSON file, so a payout run can be resumed after a restart without paying
+	// anyone twice
+	Ledger struct {
+		path  string
+		mu    sync.Mutex
+		state ledgerState
+	}
+
+	ledgerState struct {
+		// Pending are the amounts carried over to the next period by address
+		Pending map[string]uint64 `json:"pending"`
+		// Periods are the started payout runs by period
+		Periods map[string]*periodRecord `json:"periods"`
+	}
+
+	periodRecord struct {
+		// Report is the report the payouts are made for
+		Report *Report `json:"report"`
+		// Payouts are the signed payout transactions by address
+		Payouts map[string]*payoutRecord `json:"payouts"`
+		// Completed is set when all payouts are sent
+		Completed bool `json:"completed"`
+	}
+
+	payoutRecord struct {
+		// TransactionID is the ID of the payout transaction
+		TransactionID string `json:"transactionId"`
+		// Transaction is the signed payout transaction
+		Transaction json.RawMessage `json:"transaction"`
+		// Sent is set when the transaction has been accepted by a node
+		Sent bool `json:"sent"`
+	}
+)
+
+// OpenLedger loads the ledger from the file at path. A new ledger is created if the file does not exist.
+func OpenLedger(path string) (*Ledger, error) {
+	l := &Ledger{path: path}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err == nil {
+		if err := json.Unmarshal(data, &l.state); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.state.Pending == nil {
+		l.state.Pending = make(map[string]uint64)
+	}
+	if l.state.Periods == nil {
+		l.state.Periods = make(map[string]*periodRecord)
+	}
+
+	return l, nil
+}
+
+// Pending returns the amount carried over to the next period for the address
+func (l *Ledger) Pending(address string) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.state.Pending[address]
+}
+
+// IsCompleted returns whether all payouts of the period have been sent
+func (l *Ledger) IsCompleted(from, to time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record := l.state.Periods[periodID(from, to)]
+	return record != nil && record.Completed
+}
+
+// pending returns a copy of all amounts carried over
+func (l *Ledger) pending() map[string]uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	result := make(map[string]uint64, len(l.state.Pending))
+	for address, amount := range l.state.Pending {
+		result[address] = amount
+	}
+	return result
+}
+
+// report returns the report of a started payout run of the period or nil
+func (l *Ledger) report(id string) *Report {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if record := l.state.Periods[id]; record != nil {
+		return record.Report
+	}
+	return nil
+}
+
+// checkPeriod returns an error if the period overlaps a recorded period or the payout run of another period is
+// not completed yet
+func (l *Ledger) checkPeriod(from, to time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.checkPeriodLocked(from, to)
+}
+
+// checkPeriodLocked is checkPeriod for callers that hold the lock
+func (l *Ledger) checkPeriodLocked(from, to time.Time) error {
+	id := periodID(from, to)
+
+	for otherID, record := range l.state.Periods {
+		if otherID == id {
+			continue
+		}
+
+		if !record.Completed {
+			return fmt.Errorf("payout run of period %s is not completed", record.Report.period())
+		}
+		if from.Before(record.Report.To) && record.Report.From.Before(to) {
+			return fmt.Errorf("period overlaps the paid period %s", record.Report.period())
+		}
+	}
+
+	return nil
+}
+
+// begin records the start of the payout run of the report and returns the report the run was started with.
+// The period must not overlap a recorded period and the runs of all other periods must be completed.
+func (l *Ledger) begin(report *Report) (*Report, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if record := l.state.Periods[report.ID()]; record != nil {
+		return record.Report, nil
+	}
+
+	if err := l.checkPeriodLocked(report.From, report.To); err != nil {
+		return nil, err
+	}
+
+	l.state.Periods[report.ID()] = &periodRecord{Report: report, Payouts: make(map[string]*payoutRecord)}
+	return report, l.save()
+}
+
+// payout returns the recorded payout of the address in the period or nil
+func (l *Ledger) payout(id, address string) *payoutRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if record := l.state.Periods[id]; record != nil {
+		return record.Payouts[address]
+	}
+	return nil
+}
+
+// recordPayout records the signed payout transaction of the address before it is sent
+func (l *Ledger) recordPayout(id, address string, payout *payoutRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.state.Periods[id].Payouts[address] = payout
+	return l.save()
+}
+
+// markSent records that the payout of the address has been sent
+func (l *Ledger) markSent(id, address string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.state.Periods[id].Payouts[address].Sent = true
+	return l.save()
+}
+
+// complete marks the payout run of the period as completed, carries over the unpaid amounts and removes the
+// forfeited amounts of excluded voters
+func (l *Ledger) complete(id string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record := l.state.Periods[id]
+	if record.Completed {
+		return nil
+	}
+
+	for address := range record.Report.Forfeited {
+		delete(l.state.Pending, address)
+	}
+
+	for _, entry := range record.Report.Entries {
+		if amount := entry.carriedOver(); amount > 0 {
+			l.state.Pending[entry.Address] = amount
+		} else {
+			delete(l.state.Pending, entry.Address)
+		}
+	}
+
+	record.Completed = true
+	return l.save()
+}
+
+// save writes the ledger to a temporary file and renames it, so the file is never left partially written
+func (l *Ledger) save() error {
+	data, err := json.MarshalIndent(&l.state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(l.path), filepath.Base(l.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), l.path)
+}
@@ -0,0 +1,159 @@
+package payout
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/liskascend/lisk-go/api"
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+	"github.com/liskascend/lisk-go/transactions"
+)
+
+func TestLedger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ledger.json")
+
+	ledger, err := OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() returns error: %v", err)
+	}
+
+	from := time.Unix(1000, 0)
+	to := time.Unix(2000, 0)
+
+	report := computeReport(&Rules{Percentage: 100, MinPayout: 100}, 150,
+		[]*api.Voter{{Address: "1L", Balance: 1}, {Address: "2L", Balance: 2}}, nil)
+	report.From, report.To = from, to
+
+	if _, err := ledger.begin(report); err != nil {
+		t.Fatalf("Ledger.begin() returns error: %v", err)
+	}
+
+	transaction, err := transactions.NewTransaction(network.Testnet, "2L", 100, crypto.NewPassphraseSigner(""),
+		nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(transaction)
+	transactionID, _ := transaction.ID()
+
+	if err := ledger.recordPayout(report.ID(), "2L", &payoutRecord{TransactionID: transactionID,
+		Transaction: data}); err != nil {
+		t.Fatalf("Ledger.recordPayout() returns error: %v", err)
+	}
+
+	// Reopening the ledger resumes the started period
+	ledger, err = OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() returns error: %v", err)
+	}
+
+	resumed := ledger.report(report.ID())
+	if resumed == nil || len(resumed.Entries) != 2 || !resumed.From.Equal(from) {
+		t.Fatalf("Ledger.report() returns %v; want the started report", resumed)
+	}
+
+	other := &Report{From: from, To: to}
+	if started, _ := ledger.begin(other); started == other {
+		t.Error("Ledger.begin() does not return the report of the started period")
+	}
+
+	record := ledger.payout(report.ID(), "2L")
+	if record == nil || record.Sent || record.TransactionID != transactionID {
+		t.Fatalf("Ledger.payout() returns %v; want the recorded payout", record)
+	}
+
+	restored := &transactions.Transaction{}
+	if err := json.Unmarshal(record.Transaction, restored); err != nil {
+		t.Fatalf("recorded transaction can not be restored: %v", err)
+	}
+	restored.Network = network.Testnet
+	if id, _ := restored.ID(); id != transactionID {
+		t.Errorf("restored transaction has ID %s; want %s", id, transactionID)
+	}
+
+	if err := ledger.markSent(report.ID(), "2L"); err != nil {
+		t.Fatalf("Ledger.markSent() returns error: %v", err)
+	}
+	if ledger.IsCompleted(from, to) {
+		t.Error("Ledger.IsCompleted() returns true before the period is completed")
+	}
+	if err := ledger.complete(report.ID()); err != nil {
+		t.Fatalf("Ledger.complete() returns error: %v", err)
+	}
+
+	ledger, err = OpenLedger(path)
+	if err != nil {
+		t.Fatalf("OpenLedger() returns error: %v", err)
+	}
+
+	if !ledger.IsCompleted(from, to) || !ledger.payout(report.ID(), "2L").Sent {
+		t.Error("Ledger does not persist the completed period")
+	}
+	if pending := ledger.Pending("1L"); pending != 50 {
+		t.Errorf("Ledger.Pending() returns %d; want 50", pending)
+	}
+	if pending := ledger.Pending("2L"); pending != 0 {
+		t.Errorf("Ledger.Pending() returns %d; want 0", pending)
+	}
+
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("Ledger leaves %d files; want 1", len(files))
+	}
+}
+
+func TestLedger_RemovesForfeitedAmounts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ledger, err := OpenLedger(filepath.Join(dir, "ledger.json"))
+	if err != nil {
+		t.Fatalf("OpenLedger() returns error: %v", err)
+	}
+	ledger.state.Pending = map[string]uint64{"1L": 50, "2L": 30}
+
+	// 1L is excluded after amounts were carried over to it
+	rules := &Rules{Percentage: 100, MinPayout: 100, Exclude: []string{"1L"}}
+	report := computeReport(rules, 10, []*api.Voter{{Address: "1L", Balance: 1}, {Address: "2L", Balance: 1}},
+		ledger.pending())
+	report.From, report.To = time.Unix(1000, 0), time.Unix(2000, 0)
+
+	if len(report.Entries) != 1 || len(report.Forfeited) != 1 || report.Forfeited["1L"] != 50 {
+		t.Fatalf("computeReport() returns entries %v and forfeited %v; want 2L and forfeited 50 of 1L",
+			report.Entries, report.Forfeited)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := report.Write(buf); err != nil || !strings.Contains(buf.String(), "Forfeited") {
+		t.Errorf("Report.Write() writes %q,%v; want forfeited amounts", buf.String(), err)
+	}
+
+	if _, err := ledger.begin(report); err != nil {
+		t.Fatalf("Ledger.begin() returns error: %v", err)
+	}
+	if err := ledger.complete(report.ID()); err != nil {
+		t.Fatalf("Ledger.complete() returns error: %v", err)
+	}
+
+	if pending := ledger.Pending("1L"); pending != 0 {
+		t.Errorf("Ledger.Pending() returns %d for an excluded voter; want 0", pending)
+	}
+	if pending := ledger.Pending("2L"); pending != 40 {
+		t.Errorf("Ledger.Pending() returns %d; want 40", pending)
+	}
+}
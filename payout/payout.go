@@ -0,0 +1,255 @@
+// Package payout shares the rewards forged by a delegate with its voters.
+//
+// The share of a voter is weighted by its balance. The payouts of a period are computed by Engine.Plan, which can be
+// used as a dry run, and paid by Engine.Pay. All payouts are recorded in a Ledger so a payout run that is
+// interrupted can be resumed without paying anyone twice.
+package payout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liskascend/lisk-go/api"
+)
+
+type (
+	// Rules configure how the forged amount is shared with the voters
+	Rules struct {
+		// Percentage of the forged amount that is shared with the voters, between 0 and 100
+		Percentage float64
+		// IncludeFees shares the forged fees in addition to the block rewards
+		IncludeFees bool
+		// MinPayout is the minimum amount in beddows paid to a voter. Smaller amounts are carried over to the next
+		// period.
+		MinPayout uint64
+		// MaxPayout is the maximum amount in beddows paid to a voter per period. The rest is kept by the delegate.
+		// 0 means no limit.
+		MaxPayout uint64
+		// Exclude are the addresses of voters that are not paid. Their share is distributed to the other voters.
+		Exclude []string
+	}
+
+	// Report contains the payouts of a period
+	Report struct {
+		// From is the start of the period
+		From time.Time
+		// To is the end of the period
+		To time.Time
+		// Forged is the amount forged by the delegate in the period that is considered by the rules
+		Forged uint64
+		// Pool is the amount shared with the voters
+		Pool uint64
+		// TotalWeight is the sum of the balances of the paid voters
+		TotalWeight uint64
+		// Entries are the payouts of the voters
+		Entries []*Entry
+		// Forfeited are the amounts carried over to voters that are excluded now by address. They are not paid and
+		// removed from the ledger when the period is completed.
+		Forfeited map[string]uint64
+	}
+
+	// Entry is the payout of a voter
+	Entry struct {
+		// Address of the voter
+		Address string
+		// Weight is the balance of the voter
+		Weight uint64
+		// Share of the pool in the period
+		Share uint64
+		// Pending is the amount carried over from previous periods
+		Pending uint64
+		// Amount that is paid. It is 0 if the share and the pending amount are below the minimum payout.
+		Amount uint64
+	}
+)
+
+// ID returns the identifier of the period of the report
+func (r *Report) ID() string {
+	return periodID(r.From, r.To)
+}
+
+// Total returns the sum of all payouts
+func (r *Report) Total() uint64 {
+	var total uint64
+	for _, entry := range r.Entries {
+		total += entry.Amount
+	}
+	return total
+}
+
+// Write writes the report as a table to w
+func (r *Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(tw, "Period\t%s\t\n", r.period())
+	fmt.Fprintf(tw, "Forged\t%d\t\n", r.Forged)
+	fmt.Fprintf(tw, "Pool\t%d\t\n", r.Pool)
+	fmt.Fprintf(tw, "Total\t%d\t\n\n", r.Total())
+
+	fmt.Fprintln(tw, "Address\tWeight\tShare\tPending\tAmount\t")
+	for _, entry := range r.Entries {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t\n", entry.Address, entry.Weight, entry.Share, entry.Pending,
+			entry.Amount)
+	}
+
+	if len(r.Forfeited) > 0 {
+		addresses := make([]string, 0, len(r.Forfeited))
+		for address := range r.Forfeited {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+
+		fmt.Fprintln(tw, "\nForfeited\t\t\t\t\t")
+		for _, address := range addresses {
+			fmt.Fprintf(tw, "%s\t\t\t%d\t\t\n", address, r.Forfeited[address])
+		}
+	}
+
+	return tw.Flush()
+}
+
+// period returns the period of the report in a readable format
+func (r *Report) period() string {
+	return r.From.UTC().Format(time.RFC3339) + " - " + r.To.UTC().Format(time.RFC3339)
+}
+
+// isValid checks the rules
+func (r *Rules) isValid() error {
+	if r.Percentage < 0 || r.Percentage > 100 || math.IsNaN(r.Percentage) {
+		return errors.New("percentage must be between 0 and 100")
+	}
+
+	if r.MaxPayout > 0 && r.MaxPayout < r.MinPayout {
+		return errors.New("max payout must not be smaller than the min payout")
+	}
+
+	return nil
+}
+
+// isExcluded returns whether the voter is excluded from payouts
+func (r *Rules) isExcluded(address string) bool {
+	for _, excluded := range r.Exclude {
+		if excluded == address {
+			return true
+		}
+	}
+	return false
+}
+
+// forgedAmount returns the amount of the forging stats the rules apply to
+func (r *Rules) forgedAmount(stats *api.ForgingStats) (uint64, error) {
+	amount, err := strconv.ParseUint(stats.Rewards, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rewards %q: %v", stats.Rewards, err)
+	}
+
+	if r.IncludeFees {
+		fees, err := strconv.ParseUint(stats.Fees, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid fees %q: %v", stats.Fees, err)
+		}
+		amount += fees
+	}
+
+	return amount, nil
+}
+
+// computeReport shares the forged amount between the voters according to the rules.
+// Pending contains the amounts carried over from previous periods by address.
+func computeReport(rules *Rules, forged uint64, voters []*api.Voter, pending map[string]uint64) *Report {
+	report := &Report{
+		Forged: forged,
+		Pool:   mulDiv(forged, uint64(math.Round(rules.Percentage*100)), 10000),
+	}
+
+	entries := make(map[string]*Entry)
+	for _, voter := range voters {
+		if rules.isExcluded(voter.Address) || voter.Balance <= 0 || entries[voter.Address] != nil {
+			continue
+		}
+
+		entries[voter.Address] = &Entry{Address: voter.Address, Weight: uint64(voter.Balance)}
+		report.TotalWeight += uint64(voter.Balance)
+	}
+
+	// Voters that removed their vote are still paid the amount carried over
+	for address, amount := range pending {
+		if amount == 0 {
+			continue
+		}
+
+		if rules.isExcluded(address) {
+			if report.Forfeited == nil {
+				report.Forfeited = make(map[string]uint64)
+			}
+			report.Forfeited[address] = amount
+			continue
+		}
+
+		if entries[address] == nil {
+			entries[address] = &Entry{Address: address}
+		}
+		entries[address].Pending = amount
+	}
+
+	for _, entry := range entries {
+		if report.TotalWeight > 0 {
+			entry.Share = mulDiv(report.Pool, entry.Weight, report.TotalWeight)
+		}
+
+		amount := entry.Share + entry.Pending
+		if amount == 0 || amount < rules.MinPayout {
+			continue
+		}
+
+		if rules.MaxPayout > 0 && amount > rules.MaxPayout {
+			amount = rules.MaxPayout
+		}
+		entry.Amount = amount
+	}
+
+	for _, entry := range entries {
+		report.Entries = append(report.Entries, entry)
+	}
+
+	sort.Slice(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		return a.Address < b.Address
+	})
+
+	return report
+}
+
+// carriedOver returns the amount of the entry that is carried over to the next period
+func (e *Entry) carriedOver() uint64 {
+	if e.Amount > 0 {
+		return 0
+	}
+	return e.Share + e.Pending
+}
+
+// mulDiv returns a*b/c without overflowing
+func mulDiv(a, b, c uint64) uint64 {
+	result := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+	return result.Div(result, new(big.Int).SetUint64(c)).Uint64()
+}
+
+// periodID returns the identifier of the period in the ledger
+func periodID(from, to time.Time) string {
+	return fmt.Sprintf("%d-%d", toMillis(from), toMillis(to))
+}
+
+// toMillis returns the unix timestamp in milliseconds
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,137 @@
+package payout
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/liskascend/lisk-go/api"
+)
+
+func TestComputeReport(t *testing.T) {
+	voters := []*api.Voter{
+		{Address: "1L", Balance: 600},
+		{Address: "2L", Balance: 300},
+		{Address: "3L", Balance: 100},
+		{Address: "4L", Balance: 1000},
+	}
+
+	tests := []struct {
+		rules   *Rules
+		pending map[string]uint64
+		pool    uint64
+		amounts map[string]uint64
+	}{
+		{&Rules{Percentage: 50}, nil, 1000,
+			map[string]uint64{"4L": 500, "1L": 300, "2L": 150, "3L": 50}},
+		{&Rules{Percentage: 50, Exclude: []string{"4L"}}, nil, 1000,
+			map[string]uint64{"1L": 600, "2L": 300, "3L": 100}},
+		{&Rules{Percentage: 50, MinPayout: 100}, nil, 1000,
+			map[string]uint64{"4L": 500, "1L": 300, "2L": 150, "3L": 0}},
+		{&Rules{Percentage: 50, MinPayout: 100}, map[string]uint64{"3L": 60, "5L": 200}, 1000,
+			map[string]uint64{"4L": 500, "1L": 300, "2L": 150, "3L": 110, "5L": 200}},
+		{&Rules{Percentage: 50, MaxPayout: 200}, nil, 1000,
+			map[string]uint64{"4L": 200, "1L": 200, "2L": 150, "3L": 50}},
+		{&Rules{Percentage: 12.5}, nil, 250,
+			map[string]uint64{"4L": 125, "1L": 75, "2L": 37, "3L": 12}},
+	}
+
+	for i, test := range tests {
+		report := computeReport(test.rules, 2000, voters, test.pending)
+
+		if report.Pool != test.pool {
+			t.Errorf("#%d: computeReport() has pool %d; want %d", i, report.Pool, test.pool)
+		}
+
+		if len(report.Entries) != len(test.amounts) {
+			t.Errorf("#%d: computeReport() has %d entries; want %d", i, len(report.Entries), len(test.amounts))
+			continue
+		}
+
+		for _, entry := range report.Entries {
+			if amount, ok := test.amounts[entry.Address]; !ok || entry.Amount != amount {
+				t.Errorf("#%d: computeReport() pays %d to %s; want %d", i, entry.Amount, entry.Address, amount)
+			}
+		}
+	}
+}
+
+func TestComputeReportOrder(t *testing.T) {
+	voters := []*api.Voter{{Address: "2L", Balance: 1}, {Address: "3L", Balance: 5}, {Address: "1L", Balance: 1}}
+
+	report := computeReport(&Rules{Percentage: 100}, 100, voters, nil)
+
+	var addresses []string
+	for _, entry := range report.Entries {
+		addresses = append(addresses, entry.Address)
+	}
+
+	if strings.Join(addresses, ",") != "3L,1L,2L" {
+		t.Errorf("computeReport() returns entries in order %v; want [3L 1L 2L]", addresses)
+	}
+}
+
+func TestEntry_carriedOver(t *testing.T) {
+	report := computeReport(&Rules{Percentage: 100, MinPayout: 100}, 150,
+		[]*api.Voter{{Address: "1L", Balance: 1}, {Address: "2L", Balance: 2}}, map[string]uint64{"1L": 10})
+
+	for _, entry := range report.Entries {
+		var want uint64
+		if entry.Address == "1L" {
+			want = 60
+		}
+
+		if got := entry.carriedOver(); got != want {
+			t.Errorf("Entry.carriedOver() of %s returns %d; want %d", entry.Address, got, want)
+		}
+	}
+}
+
+func TestRules_isValid(t *testing.T) {
+	tests := []struct {
+		in    *Rules
+		valid bool
+	}{
+		{&Rules{Percentage: 0}, true},
+		{&Rules{Percentage: 100}, true},
+		{&Rules{Percentage: -1}, false},
+		{&Rules{Percentage: 100.1}, false},
+		{&Rules{Percentage: 10, MinPayout: 10, MaxPayout: 10}, true},
+		{&Rules{Percentage: 10, MinPayout: 10, MaxPayout: 9}, false},
+	}
+
+	for i, test := range tests {
+		if err := test.in.isValid(); (err == nil) != test.valid {
+			t.Errorf("#%d: Rules.isValid() returns %v; want valid %v", i, err, test.valid)
+		}
+	}
+}
+
+func TestRules_forgedAmount(t *testing.T) {
+	stats := &api.ForgingStats{Rewards: "500", Fees: "20"}
+
+	if amount, err := (&Rules{}).forgedAmount(stats); amount != 500 || err != nil {
+		t.Errorf("Rules.forgedAmount() returns %d,%v; want 500,nil", amount, err)
+	}
+
+	if amount, err := (&Rules{IncludeFees: true}).forgedAmount(stats); amount != 520 || err != nil {
+		t.Errorf("Rules.forgedAmount() returns %d,%v; want 520,nil", amount, err)
+	}
+
+	if _, err := (&Rules{}).forgedAmount(&api.ForgingStats{Rewards: "abc"}); err == nil {
+		t.Error("Rules.forgedAmount() does not return an error for invalid rewards")
+	}
+}
+
+func TestReport_Write(t *testing.T) {
+	report := computeReport(&Rules{Percentage: 100}, 100, []*api.Voter{{Address: "1L", Balance: 1}}, nil)
+
+	buf := &bytes.Buffer{}
+	if err := report.Write(buf); err != nil {
+		t.Fatalf("Report.Write() returns error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "1L") || report.Total() != 100 {
+		t.Errorf("Report.Write() writes %q", buf.String())
+	}
+}
@@ -1,11 +1,12 @@
 package transactions
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-
-	"errors"
+	"strings"
 
 	"golang.org/x/crypto/ed25519"
 )
@@ -44,15 +45,15 @@ type (
 		// Lifetime is the lifetime of the transaction in which it can be signed
 		Lifetime byte `json:"lifetime"`
 		// AddKeys is a slice of keys to add to the multisignature wallet
-		AddKeys [][]byte `json:"_"`
-		// AddKeys is a slice of keys to remove from the multisignature wallet
-		RemoveKeys [][]byte `json:"_"`
+		AddKeys [][]byte `json:"-"`
+		// RemoveKeys is a slice of keys to remove from the multisignature wallet
+		RemoveKeys [][]byte `json:"-"`
 	}
 
 	// CreateDappAsset is the asset needed for a TransactionTypeDappRegistration
 	CreateDappAsset struct {
 		// Dapp is the dapp that should be registered
-		Dapp *Dapp
+		Dapp *Dapp `json:"dapp"`
 	}
 
 	// TransferInDappAsset is the asset needed for a TransactionTypeTransferInSidechain
@@ -93,24 +94,43 @@ func (r *RegisterMultisignatureAccountAsset) serialize() ([]byte, error) {
 
 // IsValid returns whether the asset is valid
 func (r *RegisterMultisignatureAccountAsset) IsValid() (bool, error) {
-	if len(r.AddKeys)+len(r.RemoveKeys) == 0 {
-		return false, errors.New("no keys specified to remove or add")
+	return validationResult(r.validate())
+}
+
+func (r *RegisterMultisignatureAccountAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	keys := append(append([][]byte{}, r.AddKeys...), r.RemoveKeys...)
+
+	if len(keys) == 0 {
+		errs.add("asset.multisignature.keysgroup", "no keys specified to remove or add")
+	} else if len(keys) > maxKeysgroupSize {
+		errs.add("asset.multisignature.keysgroup", "exceeds the maximum size of %d keys", maxKeysgroupSize)
 	}
 
-	if bytesSliceContainsDuplicates(append(r.AddKeys, r.RemoveKeys...)) {
-		return false, errors.New("addKeys and/or removeKeys contain duplicates")
+	if bytesSliceContainsDuplicates(keys) {
+		errs.add("asset.multisignature.keysgroup", "contains duplicates")
 	}
 
-	for _, key := range append(r.AddKeys, r.RemoveKeys...) {
+	for _, key := range keys {
 		if len(key) != ed25519.PublicKeySize {
-			return false, fmt.Errorf(
-				"key %s has invalid length %d",
-				hex.EncodeToString(key),
+			errs.add("asset.multisignature.keysgroup", "key %s has invalid length %d", hex.EncodeToString(key),
 				len(key))
 		}
 	}
 
-	return true, nil
+	if r.Min < minMultisignatureMin || r.Min > maxMultisignatureMin {
+		errs.add("asset.multisignature.min", "must be between %d and %d", minMultisignatureMin, maxMultisignatureMin)
+	} else if int(r.Min) > len(keys) {
+		errs.add("asset.multisignature.min", "must not exceed the number of keys")
+	}
+
+	if r.Lifetime < minMultisignatureLifetime || r.Lifetime > maxMultisignatureLifetime {
+		errs.add("asset.multisignature.lifetime", "must be between %d and %d", minMultisignatureLifetime,
+			maxMultisignatureLifetime)
+	}
+
+	return errs
 }
 
 // MarshalJSON marshals the asset to the lisk JSON format
@@ -139,6 +159,32 @@ func (r *RegisterMultisignatureAccountAsset) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (r *RegisterMultisignatureAccountAsset) UnmarshalJSON(data []byte) error {
+	payload := &struct {
+		Payload struct {
+			Min       byte     `json:"min"`
+			Lifetime  byte     `json:"lifetime"`
+			Keysgroup []string `json:"keysgroup"`
+		} `json:"multisignature"`
+	}{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	addKeys, removeKeys, err := parseSignedPublicKeys(payload.Payload.Keysgroup)
+	if err != nil {
+		return fmt.Errorf("invalid keysgroup: %v", err)
+	}
+
+	r.Min = payload.Payload.Min
+	r.Lifetime = payload.Payload.Lifetime
+	r.AddKeys = addKeys
+	r.RemoveKeys = removeKeys
+
+	return nil
+}
+
 func (c *CastVoteAsset) serialize() ([]byte, error) {
 	if valid, err := c.IsValid(); !valid {
 		return nil, err
@@ -158,24 +204,29 @@ func (c *CastVoteAsset) serialize() ([]byte, error) {
 
 // IsValid returns whether the asset is valid
 func (c *CastVoteAsset) IsValid() (bool, error) {
-	if len(c.Votes)+len(c.Unvotes) > 33 {
-		return false, errors.New("too many votes/unvotes")
+	return validationResult(c.validate())
+}
+
+func (c *CastVoteAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	votes := append(append([][]byte{}, c.Votes...), c.Unvotes...)
+
+	if len(votes) > maxVotesPerTransaction {
+		errs.add("asset.votes", "exceeds the maximum of %d votes and unvotes", maxVotesPerTransaction)
 	}
 
-	for _, vote := range append(c.Votes, c.Unvotes...) {
+	for _, vote := range votes {
 		if len(vote) != ed25519.PublicKeySize {
-			return false, fmt.Errorf(
-				"vote %s has invalid length %d",
-				hex.EncodeToString(vote),
-				len(vote))
+			errs.add("asset.votes", "vote %s has invalid length %d", hex.EncodeToString(vote), len(vote))
 		}
 	}
 
-	if bytesSliceContainsDuplicates(append(c.Votes, c.Unvotes...)) {
-		return false, errors.New("votes and/or votes contain duplicates")
+	if bytesSliceContainsDuplicates(votes) {
+		errs.add("asset.votes", "contains duplicates")
 	}
 
-	return true, nil
+	return errs
 }
 
 // MarshalJSON marshals the asset to the lisk JSON format
@@ -198,6 +249,26 @@ func (c *CastVoteAsset) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (c *CastVoteAsset) UnmarshalJSON(data []byte) error {
+	payload := &struct {
+		Votes []string `json:"votes"`
+	}{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	votes, unvotes, err := parseSignedPublicKeys(payload.Votes)
+	if err != nil {
+		return fmt.Errorf("invalid votes: %v", err)
+	}
+
+	c.Votes = votes
+	c.Unvotes = unvotes
+
+	return nil
+}
+
 func (r *RegisterDelegateAsset) serialize() ([]byte, error) {
 	if valid, err := r.IsValid(); !valid {
 		return nil, err
@@ -205,21 +276,33 @@ func (r *RegisterDelegateAsset) serialize() ([]byte, error) {
 	return []byte(r.Username), nil
 }
 
-// IsValid returns whether the asset is valid
+// IsValid returns whether the asset is valid.
+// Usernames must be lowercase and may only contain alphanumeric characters and !@$&_.
 func (r *RegisterDelegateAsset) IsValid() (bool, error) {
-	if len(r.Username) == 0 {
-		return false, errors.New("username must not be empty")
-	}
+	return validationResult(r.validate())
+}
 
-	if len(r.Username) > 20 {
-		return false, errors.New("username exceeds the maximum length of 20 characters")
+func (r *RegisterDelegateAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	switch {
+	case len(strings.TrimSpace(r.Username)) == 0:
+		errs.add("asset.delegate.username", "must not be empty")
+	case len(r.Username) > maxUsernameLength:
+		errs.add("asset.delegate.username", "exceeds the maximum length of %d characters", maxUsernameLength)
+	case r.Username != strings.ToLower(r.Username):
+		errs.add("asset.delegate.username", "must be lowercase")
+	case addressPattern.MatchString(r.Username):
+		errs.add("asset.delegate.username", "must not be a potential address")
+	case !usernamePattern.MatchString(r.Username):
+		errs.add("asset.delegate.username", "may only contain alphanumeric characters and !@$&_.")
 	}
 
 	if len(r.PublicKey) != ed25519.PublicKeySize {
-		return false, errors.New("invalid public key length")
+		errs.add("asset.delegate.publicKey", "invalid public key length")
 	}
 
-	return true, nil
+	return errs
 }
 
 // MarshalJSON marshals the asset to the lisk JSON format
@@ -237,6 +320,33 @@ func (r *RegisterDelegateAsset) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// UnmarshalJSON unmarshals the asset from the lisk JSON format.
+// The node may omit the public key, in which case it has to be set to the sender's public key.
+func (r *RegisterDelegateAsset) UnmarshalJSON(data []byte) error {
+	payload := &struct {
+		Payload struct {
+			Username  string `json:"username"`
+			PublicKey string `json:"publicKey"`
+		} `json:"delegate"`
+	}{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	var publicKey []byte
+	if payload.Payload.PublicKey != "" {
+		var err error
+		if publicKey, err = hex.DecodeString(payload.Payload.PublicKey); err != nil {
+			return fmt.Errorf("invalid public key: %v", err)
+		}
+	}
+
+	r.Username = payload.Payload.Username
+	r.PublicKey = publicKey
+
+	return nil
+}
+
 func (r *RegisterSecondSignatureAsset) serialize() ([]byte, error) {
 	if valid, err := r.IsValid(); !valid {
 		return nil, err
@@ -246,14 +356,18 @@ func (r *RegisterSecondSignatureAsset) serialize() ([]byte, error) {
 
 // IsValid returns whether the asset is valid
 func (r *RegisterSecondSignatureAsset) IsValid() (bool, error) {
+	return validationResult(r.validate())
+}
+
+func (r *RegisterSecondSignatureAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
 	if len(r.PublicKey) != ed25519.PublicKeySize {
-		return false,
-			fmt.Errorf(
-				"public key %s has invalid length %d",
-				hex.EncodeToString(r.PublicKey),
-				len(r.PublicKey))
+		errs.add("asset.signature.publicKey", "public key %s has invalid length %d",
+			hex.EncodeToString(r.PublicKey), len(r.PublicKey))
 	}
-	return true, nil
+
+	return errs
 }
 
 // MarshalJSON marshals the asset to the lisk JSON format
@@ -269,6 +383,27 @@ func (r *RegisterSecondSignatureAsset) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (r *RegisterSecondSignatureAsset) UnmarshalJSON(data []byte) error {
+	payload := &struct {
+		Payload struct {
+			PublicKey string `json:"publicKey"`
+		} `json:"signature"`
+	}{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	publicKey, err := hex.DecodeString(payload.Payload.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %v", err)
+	}
+
+	r.PublicKey = publicKey
+
+	return nil
+}
+
 func (a DataAsset) serialize() ([]byte, error) {
 	if valid, err := a.IsValid(); !valid {
 		return nil, err
@@ -278,10 +413,17 @@ func (a DataAsset) serialize() ([]byte, error) {
 
 // IsValid returns whether the asset is valid
 func (a DataAsset) IsValid() (bool, error) {
+	return validationResult(a.validate())
+}
+
+func (a DataAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
 	if len([]byte(a)) > byteSizeData {
-		return false, errors.New("data length exceeds maximum payload size")
+		errs.add("asset.data", "exceeds the maximum size of %d bytes", byteSizeData)
 	}
-	return true, nil
+
+	return errs
 }
 
 // MarshalJSON marshals the asset to the lisk JSON format
@@ -292,3 +434,180 @@ func (a DataAsset) MarshalJSON() ([]byte, error) {
 		Data: string(a),
 	})
 }
+
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (a *DataAsset) UnmarshalJSON(data []byte) error {
+	payload := &struct {
+		Data string `json:"data"`
+	}{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	*a = DataAsset(payload.Data)
+
+	return nil
+}
+
+func (c *CreateDappAsset) serialize() ([]byte, error) {
+	if valid, err := c.IsValid(); !valid {
+		return nil, err
+	}
+
+	dst := new(bytes.Buffer)
+	dst.WriteString(c.Dapp.Name)
+	dst.WriteString(c.Dapp.Description)
+	dst.WriteString(c.Dapp.Tags)
+	dst.WriteString(c.Dapp.Link)
+	dst.WriteString(c.Dapp.Icon)
+	binary.Write(dst, binary.LittleEndian, c.Dapp.Type)
+	binary.Write(dst, binary.LittleEndian, c.Dapp.Category)
+
+	return dst.Bytes(), nil
+}
+
+// IsValid returns whether the asset is valid
+func (c *CreateDappAsset) IsValid() (bool, error) {
+	return validationResult(c.validate())
+}
+
+func (c *CreateDappAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if c.Dapp == nil {
+		errs.add("asset.dapp", "must not be empty")
+		return errs
+	}
+
+	if len(strings.TrimSpace(c.Dapp.Name)) == 0 || strings.TrimSpace(c.Dapp.Name) != c.Dapp.Name {
+		errs.add("asset.dapp.name", "must not be empty or contain leading or trailing spaces")
+	} else if len(c.Dapp.Name) > maxDappNameLength {
+		errs.add("asset.dapp.name", "exceeds the maximum length of %d characters", maxDappNameLength)
+	}
+
+	if len(c.Dapp.Description) > maxDappDescriptionLength {
+		errs.add("asset.dapp.description", "exceeds the maximum length of %d characters", maxDappDescriptionLength)
+	}
+
+	if len(c.Dapp.Tags) > maxDappTagsLength {
+		errs.add("asset.dapp.tags", "exceeds the maximum length of %d characters", maxDappTagsLength)
+	}
+
+	if c.Dapp.Tags != "" {
+		var tags []string
+		for _, tag := range strings.Split(c.Dapp.Tags, ",") {
+			tag = strings.TrimSpace(tag)
+			if containsString(tags, tag) {
+				errs.add("asset.dapp.tags", "contains duplicate tag %s", tag)
+				break
+			}
+			tags = append(tags, tag)
+		}
+	}
+
+	if !isValidURL(c.Dapp.Link, ".zip") {
+		errs.add("asset.dapp.link", "must be a URL of a zip archive")
+	}
+
+	if c.Dapp.Icon != "" && !isValidURL(c.Dapp.Icon, ".png", ".jpeg", ".jpg") {
+		errs.add("asset.dapp.icon", "must be a URL of a png or jpeg image")
+	}
+
+	if c.Dapp.Type != DappTypeDapp {
+		errs.add("asset.dapp.type", "invalid dapp type")
+	}
+
+	if c.Dapp.Category > DappCategoryUtilities {
+		errs.add("asset.dapp.category", "invalid dapp category")
+	}
+
+	return errs
+}
+
+func (t *TransferInDappAsset) serialize() ([]byte, error) {
+	if valid, err := t.IsValid(); !valid {
+		return nil, err
+	}
+	return []byte(t.DappID), nil
+}
+
+// IsValid returns whether the asset is valid
+func (t *TransferInDappAsset) IsValid() (bool, error) {
+	return validationResult(t.validate())
+}
+
+func (t *TransferInDappAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if !isValidID(t.DappID) {
+		errs.add("asset.inTransfer.dappId", "invalid id")
+	}
+
+	return errs
+}
+
+// MarshalJSON marshals the asset to the lisk JSON format
+func (t *TransferInDappAsset) MarshalJSON() ([]byte, error) {
+	type AssetWrapper TransferInDappAsset
+	return json.Marshal(&struct {
+		Payload *AssetWrapper `json:"inTransfer"`
+	}{
+		Payload: (*AssetWrapper)(t),
+	})
+}
+
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (t *TransferInDappAsset) UnmarshalJSON(data []byte) error {
+	type AssetWrapper TransferInDappAsset
+	return json.Unmarshal(data, &struct {
+		Payload *AssetWrapper `json:"inTransfer"`
+	}{
+		Payload: (*AssetWrapper)(t),
+	})
+}
+
+func (t *TransferOutDappAsset) serialize() ([]byte, error) {
+	if valid, err := t.IsValid(); !valid {
+		return nil, err
+	}
+	return []byte(t.DappID + t.TransactionID), nil
+}
+
+// IsValid returns whether the asset is valid
+func (t *TransferOutDappAsset) IsValid() (bool, error) {
+	return validationResult(t.validate())
+}
+
+func (t *TransferOutDappAsset) validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if !isValidID(t.DappID) {
+		errs.add("asset.outTransfer.dappId", "invalid id")
+	}
+
+	if !isValidID(t.TransactionID) {
+		errs.add("asset.outTransfer.transactionId", "invalid id")
+	}
+
+	return errs
+}
+
+// MarshalJSON marshals the asset to the lisk JSON format
+func (t *TransferOutDappAsset) MarshalJSON() ([]byte, error) {
+	type AssetWrapper TransferOutDappAsset
+	return json.Marshal(&struct {
+		Payload *AssetWrapper `json:"outTransfer"`
+	}{
+		Payload: (*AssetWrapper)(t),
+	})
+}
+
+// UnmarshalJSON unmarshals the asset from the lisk JSON format
+func (t *TransferOutDappAsset) UnmarshalJSON(data []byte) error {
+	type AssetWrapper TransferOutDappAsset
+	return json.Unmarshal(data, &struct {
+		Payload *AssetWrapper `json:"outTransfer"`
+	}{
+		Payload: (*AssetWrapper)(t),
+	})
+}
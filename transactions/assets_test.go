@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"golang.org/x/crypto/ed25519"
@@ -30,6 +32,18 @@ type (
 		in  *RegisterMultisignatureAccountAsset
 		out bool
 	}
+	CreateDappTest struct {
+		in  *CreateDappAsset
+		out bool
+	}
+	TransferInDappTest struct {
+		in  *TransferInDappAsset
+		out bool
+	}
+	TransferOutDappTest struct {
+		in  *TransferOutDappAsset
+		out bool
+	}
 )
 
 var (
@@ -60,6 +74,13 @@ var (
 		{in: &RegisterDelegateAsset{"olf", nil}, out: false},
 		{in: &RegisterDelegateAsset{"aaaaaaaaaaaaaaaaaaaaa", publicKey}, out: false},
 		{in: &RegisterDelegateAsset{"abc", publicKey}, out: true},
+		{in: &RegisterDelegateAsset{"genesis_1!@$&.", publicKey}, out: true},
+		{in: &RegisterDelegateAsset{"Genesis_1", publicKey}, out: false},
+		{in: &RegisterDelegateAsset{"   ", publicKey}, out: false},
+		{in: &RegisterDelegateAsset{"ab c", publicKey}, out: false},
+		{in: &RegisterDelegateAsset{"ab#c", publicKey}, out: false},
+		{in: &RegisterDelegateAsset{"18160565574430594874L", publicKey}, out: false},
+		{in: &RegisterDelegateAsset{"1234l", publicKey}, out: false},
 	}
 	RegisterSecondSignatureTests = []RegisterSecondSignatureTest{
 		{&RegisterSecondSignatureAsset{}, false},
@@ -68,15 +89,52 @@ var (
 	}
 	RegisterMultisignatureAccountTests = []RegisterMultisignatureAccountTest{
 		{&RegisterMultisignatureAccountAsset{}, false},
-		{&RegisterMultisignatureAccountAsset{AddKeys: [][]byte{publicKey, publicKey2}}, true},
-		{&RegisterMultisignatureAccountAsset{RemoveKeys: [][]byte{publicKey, publicKey2}}, true},
-		{&RegisterMultisignatureAccountAsset{AddKeys: [][]byte{publicKey}, RemoveKeys: [][]byte{publicKey2}}, true},
+		{&RegisterMultisignatureAccountAsset{Min: 2, Lifetime: 24, AddKeys: [][]byte{publicKey, publicKey2}}, true},
+		{&RegisterMultisignatureAccountAsset{Min: 1, Lifetime: 1, RemoveKeys: [][]byte{publicKey, publicKey2}}, true},
+		{&RegisterMultisignatureAccountAsset{Min: 2, Lifetime: 72, AddKeys: [][]byte{publicKey}, RemoveKeys: [][]byte{publicKey2}}, true},
+		{&RegisterMultisignatureAccountAsset{Lifetime: 24, AddKeys: [][]byte{publicKey, publicKey2}}, false},
+		{&RegisterMultisignatureAccountAsset{Min: 3, Lifetime: 24, AddKeys: [][]byte{publicKey, publicKey2}}, false},
+		{&RegisterMultisignatureAccountAsset{Min: 16, Lifetime: 24, AddKeys: randomValidPublicKeys(16)}, false},
+		{&RegisterMultisignatureAccountAsset{Min: 2, AddKeys: [][]byte{publicKey, publicKey2}}, false},
+		{&RegisterMultisignatureAccountAsset{Min: 2, Lifetime: 73, AddKeys: [][]byte{publicKey, publicKey2}}, false},
 		{&RegisterMultisignatureAccountAsset{AddKeys: [][]byte{publicKey}, RemoveKeys: [][]byte{publicKey}}, false},
 		{&RegisterMultisignatureAccountAsset{AddKeys: [][]byte{publicKey, publicKey2, publicKey}}, false},
 		{&RegisterMultisignatureAccountAsset{RemoveKeys: [][]byte{publicKey, publicKey2, publicKey}}, false},
 		{&RegisterMultisignatureAccountAsset{AddKeys: [][]byte{publicKey, []byte("abc")}}, false},
 		{&RegisterMultisignatureAccountAsset{RemoveKeys: [][]byte{publicKey, []byte("abc")}}, false},
 	}
+	CreateDappTests = []CreateDappTest{
+		{&CreateDappAsset{}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip"}}, true},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Icon: "https://a.io/a.png", Tags: "a, b",
+			Description: "abc", Category: DappCategoryUtilities}}, true},
+		{&CreateDappAsset{&Dapp{Link: "https://a.io/a.zip"}}, false},
+		{&CreateDappAsset{&Dapp{Name: " a", Link: "https://a.io/a.zip"}}, false},
+		{&CreateDappAsset{&Dapp{Name: strings.Repeat("a", 33), Link: "https://a.io/a.zip"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Description: strings.Repeat("a", 161)}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Tags: strings.Repeat("a", 161)}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Tags: "a, b,a"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "a.zip"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.tar"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/" + strings.Repeat("a", 2000) + ".zip"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Icon: "https://a.io/a.gif"}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Type: 1}}, false},
+		{&CreateDappAsset{&Dapp{Name: "a", Link: "https://a.io/a.zip", Category: 7}}, false},
+	}
+	TransferInDappTests = []TransferInDappTest{
+		{&TransferInDappAsset{}, false},
+		{&TransferInDappAsset{DappID: "1234213"}, true},
+		{&TransferInDappAsset{DappID: "1234213a"}, false},
+		{&TransferInDappAsset{DappID: "123456789012345678901"}, false},
+	}
+	TransferOutDappTests = []TransferOutDappTest{
+		{&TransferOutDappAsset{}, false},
+		{&TransferOutDappAsset{DappID: "1234213", TransactionID: "13987348420913138422"}, true},
+		{&TransferOutDappAsset{DappID: "1234213"}, false},
+		{&TransferOutDappAsset{TransactionID: "13987348420913138422"}, false},
+		{&TransferOutDappAsset{DappID: "1234213", TransactionID: "L"}, false},
+	}
 )
 
 func TestDataAsset_IsValid(t *testing.T) {
@@ -126,6 +184,33 @@ func TestRegisterMultisignatureAccountAsset_IsValid(t *testing.T) {
 	}
 }
 
+func TestCreateDappAsset_IsValid(t *testing.T) {
+	for i, test := range CreateDappTests {
+		val, err := test.in.IsValid()
+		if val != test.out {
+			t.Errorf("#%d: CreateDappAsset.IsValid(%v)=%v,%v; want %v", i, test.in.Dapp, val, err, test.out)
+		}
+	}
+}
+
+func TestTransferInDappAsset_IsValid(t *testing.T) {
+	for i, test := range TransferInDappTests {
+		val, err := test.in.IsValid()
+		if val != test.out {
+			t.Errorf("#%d: TransferInDappAsset.IsValid(%v)=%v,%v; want %v", i, test.in, val, err, test.out)
+		}
+	}
+}
+
+func TestTransferOutDappAsset_IsValid(t *testing.T) {
+	for i, test := range TransferOutDappTests {
+		val, err := test.in.IsValid()
+		if val != test.out {
+			t.Errorf("#%d: TransferOutDappAsset.IsValid(%v)=%v,%v; want %v", i, test.in, val, err, test.out)
+		}
+	}
+}
+
 func randomValidPublicKeys(num int) [][]byte {
 	var keys [][]byte
 	for i := 0; i < num; i++ {
@@ -182,12 +267,12 @@ func TestCastVoteAssetSerialize(t *testing.T) {
 
 func TestRegisterMultisignatureAccountAssetSerialize(t *testing.T) {
 	asset := &RegisterMultisignatureAccountAsset{
-		Min:        48,
-		Lifetime:   49,
+		Min:        2,
+		Lifetime:   10,
 		AddKeys:    [][]byte{defaultSenderPublicKey},
 		RemoveKeys: [][]byte{defaultSenderSecondPublicKey},
 	}
-	if data, err := asset.serialize(); !bytes.Equal(data, []byte("01+5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09-0401c8ac9f29ded9e1e4d5b6b43051cb25b22f27c7b7b35092161e851946f82f")) || err != nil {
+	if data, err := asset.serialize(); !bytes.Equal(data, []byte("\x02\n+5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09-0401c8ac9f29ded9e1e4d5b6b43051cb25b22f27c7b7b35092161e851946f82f")) || err != nil {
 		t.Errorf("RegisterMultisignatureAccountAsset.serialize()=%v,%v; want %v", data, err, []byte("abc"))
 	}
 }
@@ -232,3 +317,29 @@ func TestRegisterMultisignatureAccountAsset_MarshalJSON(t *testing.T) {
 		t.Errorf("RegisterDelegateAsset.MarshalJSON()=%s,%v; want %v", data, err, "")
 	}
 }
+
+func TestCreateDappAsset_MarshalJSON(t *testing.T) {
+	asset := &CreateDappAsset{Dapp: &Dapp{
+		Name:     "Lisk Guestbook",
+		Link:     "https://a.io/a.zip",
+		Category: DappCategoryEducation,
+		Tags:     "guestbook",
+	}}
+	if data, err := json.Marshal(asset); string(data) != `{"dapp":{"name":"Lisk Guestbook","link":"https://a.io/a.zip","type":0,"category":0,"tags":"guestbook"}}` || err != nil {
+		t.Errorf("CreateDappAsset.MarshalJSON()=%s,%v; want %v", data, err, "")
+	}
+}
+
+func TestTransferInDappAsset_MarshalJSON(t *testing.T) {
+	asset := &TransferInDappAsset{DappID: defaultAppId}
+	if data, err := asset.MarshalJSON(); string(data) != `{"inTransfer":{"dappId":"1234213"}}` || err != nil {
+		t.Errorf("TransferInDappAsset.MarshalJSON()=%s,%v; want %v", data, err, "")
+	}
+}
+
+func TestTransferOutDappAsset_MarshalJSON(t *testing.T) {
+	asset := &TransferOutDappAsset{DappID: defaultAppId, TransactionID: defaultTransactionId}
+	if data, err := asset.MarshalJSON(); string(data) != `{"outTransfer":{"dappId":"1234213","transactionId":"13987348420913138422"}}` || err != nil {
+		t.Errorf("TransferOutDappAsset.MarshalJSON()=%s,%v; want %v", data, err, "")
+	}
+}
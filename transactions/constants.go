@@ -1,29 +1,38 @@
 package transactions
 
-import "time"
+import (
+	"time"
 
-const (
-	fixedPoint = 100000000
+	"github.com/liskascend/lisk-go/network"
+)
 
-	feeSend           = 0.1 * fixedPoint
-	feeData           = 0.1 * fixedPoint
-	feeTransferIn     = 0.1 * fixedPoint
-	feeTransferOut    = 0.1 * fixedPoint
-	feeSignature      = 5 * fixedPoint
-	feeDelegate       = 25 * fixedPoint
-	feeVote           = 1 * fixedPoint
-	feeMultisignature = 5 * fixedPoint
-	feeDapp           = 25 * fixedPoint
+const (
+	fixedPoint = network.FixedPoint
 
-	//byteSizeTimestamp                  = 4
-	byteSizeRecipientID = 8
-	//byteSizeAmount                     = 8
+	byteSizeTimestamp                  = 4
+	byteSizeRecipientID                = 8
+	byteSizeAmount                     = 8
 	byteSizeSignatureTransaction       = 64
 	byteSizeSecondSignatureTransaction = 64
 	byteSizeData                       = 64
+
+	maxUsernameLength        = 20
+	maxDappNameLength        = 32
+	maxDappDescriptionLength = 160
+	maxDappTagsLength        = 160
+	maxDappURLLength         = 2000
+	maxIDLength              = 20
+
+	maxAmount                 = 10000000000000000
+	maxVotesPerTransaction    = 33
+	maxKeysgroupSize          = 15
+	minMultisignatureMin      = 1
+	maxMultisignatureMin      = 15
+	minMultisignatureLifetime = 1
+	maxMultisignatureLifetime = 72
 )
 
 var (
-	epochTime   = time.Date(2016, 5, 24, 17, 0, 0, 0, time.UTC)
+	epochTime   = network.Mainnet.Epoch
 	epochTimeMs = epochTime.UTC().UnixNano() / int64(time.Millisecond)
 )
@@ -1,46 +1,48 @@
 package transactions
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
 )
 
-// NewTransaction creates a new value transfer transaction and signs it using the given secrets.
-// The second secret is optional and only required for lisk wallets with a second signature.
-func NewTransaction(recipientID string, amount uint64, secret string, secondSecret string, timeOffset int64) (
+// NewTransaction creates a new value transfer transaction for the network and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
+func NewTransaction(net *network.Network, recipientID string, amount uint64, signer crypto.Signer,
+	secondSigner crypto.Signer, timeOffset int64) (
 	*Transaction, error) {
-	timestamp := GetCurrentTimeWithOffset(timeOffset)
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
 
 	transaction := &Transaction{
 		Type:        TransactionTypeNormal,
 		Amount:      amount,
 		RecipientID: recipientID,
 		Timestamp:   timestamp,
+		Network:     net,
 	}
 
-	pubKey := crypto.GetPublicKeyFromSecret(secret)
-	transaction.SenderPublicKey = pubKey
-
-	privKey := crypto.GetPrivateKeyFromSecret(secret)
-	transaction.Sign(privKey)
-
-	// Add a second signature if a second secret is given
-	if secondSecret != "" {
-		privKey2 := crypto.GetPrivateKeyFromSecret(secondSecret)
-		transaction.SecondSign(privKey2)
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
 	}
 
 	return transaction, nil
 }
 
-// NewTransactionWithData creates a new value transfer transaction with data and signs it using the given secrets.
-// The second secret is optional and only required for lisk wallets with a second signature.
+// NewTransactionWithData creates a new value transfer transaction with data and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
 // Data can be a string or byte slice with a maximum length of 64 bytes.
-func NewTransactionWithData(
-	recipientID string, amount uint64, secret string, secondSecret string, timeOffset int64, data interface{}) (
+func NewTransactionWithData(net *network.Network,
+	recipientID string, amount uint64, signer crypto.Signer, secondSigner crypto.Signer, timeOffset int64, data interface{}) (
 	*Transaction, error) {
-	timestamp := GetCurrentTimeWithOffset(timeOffset)
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
 
 	var castedData []byte
 
@@ -58,65 +60,66 @@ func NewTransactionWithData(
 		Amount:      amount,
 		RecipientID: recipientID,
 		Timestamp:   timestamp,
+		Network:     net,
 		Asset:       DataAsset(castedData),
 	}
 
-	pubKey := crypto.GetPublicKeyFromSecret(secret)
-	transaction.SenderPublicKey = pubKey
-
-	privKey := crypto.GetPrivateKeyFromSecret(secret)
-	transaction.Sign(privKey)
-
-	// Add a second signature if a second secret is given
-	if secondSecret != "" {
-		privKey2 := crypto.GetPrivateKeyFromSecret(secondSecret)
-		transaction.SecondSign(privKey2)
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
 	}
 
 	return transaction, nil
 }
 
-// NewSecondSignatureTransaction creates a new transaction to create a second signature
-// and signs it using the given secrets.
-func NewSecondSignatureTransaction(
-	recipientID string, secret string, newSecondSecret string, timeOffset int64) (
+// NewSecondSignatureTransaction creates a new transaction to register the given second public key
+// and signs it using the given signer.
+func NewSecondSignatureTransaction(net *network.Network, signer crypto.Signer, newSecondPublicKey []byte,
+	timeOffset int64) (
 	*Transaction, error) {
-	timestamp := GetCurrentTimeWithOffset(timeOffset)
-
-	secondSecretPublicKey := crypto.GetPublicKeyFromSecret(newSecondSecret)
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
 
 	transaction := &Transaction{
-		Type:        TransactionTypeSecondSecretRegistration,
-		Amount:      0,
-		RecipientID: recipientID,
-		Timestamp:   timestamp,
+		Type:      TransactionTypeSecondSecretRegistration,
+		Amount:    0,
+		Timestamp: timestamp,
+		Network:   net,
 		Asset: &RegisterSecondSignatureAsset{
-			PublicKey: secondSecretPublicKey,
+			PublicKey: newSecondPublicKey,
 		},
 	}
 
-	pubKey := crypto.GetPublicKeyFromSecret(secret)
-	transaction.SenderPublicKey = pubKey
-
-	privKey := crypto.GetPrivateKeyFromSecret(secret)
-	transaction.Sign(privKey)
+	if err := transaction.signWith(signer, nil); err != nil {
+		return nil, err
+	}
 
 	return transaction, nil
 }
 
-// NewVoteTransaction creates a new vote transaction and signs it using the given secrets.
-// The second secret is optional and only required for lisk wallets with a second signature.
+// NewVoteTransaction creates a new vote transaction and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
 // The votes and unvotes are binary representations of the public keys of the relevant delegates.
-func NewVoteTransaction(recipientID string, secret string, secondSecret string, timeOffset int64,
+// The transaction is sent to the address of the signer.
+func NewVoteTransaction(net *network.Network, signer crypto.Signer, secondSigner crypto.Signer, timeOffset int64,
 	votes [][]byte, unvotes [][]byte) (
 	*Transaction, error) {
-	timestamp := GetCurrentTimeWithOffset(timeOffset)
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	if signer == nil {
+		return nil, errors.New("signer must not be nil")
+	}
 
 	transaction := &Transaction{
 		Type:        TransactionTypeVote,
 		Amount:      0,
-		RecipientID: recipientID,
+		RecipientID: crypto.GetAddressFromPublicKey(signer.PublicKey()),
 		Timestamp:   timestamp,
+		Network:     net,
 		Asset: &CastVoteAsset{
 			Votes:   votes,
 			Unvotes: unvotes,
@@ -127,37 +130,32 @@ func NewVoteTransaction(recipientID string, secret string, secondSecret string,
 		return nil, err
 	}
 
-	pubKey := crypto.GetPublicKeyFromSecret(secret)
-	transaction.SenderPublicKey = pubKey
-
-	privKey := crypto.GetPrivateKeyFromSecret(secret)
-	transaction.Sign(privKey)
-
-	// Add a second signature if a second secret is given
-	if secondSecret != "" {
-		privKey2 := crypto.GetPrivateKeyFromSecret(secondSecret)
-		transaction.SecondSign(privKey2)
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
 	}
 
 	return transaction, nil
 }
 
 // NewMultisignatureRegistrationTransaction creates a new transaction to create/update multisignature accounts
-// and signs it using the given secrets.
-// The second secret is optional and only required for lisk wallets with a second signature.
+// and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
 // The keys are binary representations of the public keys of the relevant delegates.
 // Lifetime is the pending transaction lifetime.
 // Min is the minimum number of signatures required.
-func NewMultisignatureRegistrationTransaction(recipientID string, secret string, secondSecret string, timeOffset int64,
-	addKeys [][]byte, removeKeys [][]byte, Lifetime byte, min byte) (
+func NewMultisignatureRegistrationTransaction(net *network.Network, signer crypto.Signer, secondSigner crypto.Signer,
+	timeOffset int64, addKeys [][]byte, removeKeys [][]byte, Lifetime byte, min byte) (
 	*Transaction, error) {
-	timestamp := GetCurrentTimeWithOffset(timeOffset)
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
 
 	transaction := &Transaction{
-		Type:        TransactionTypeMultisignatureRegistration,
-		Amount:      0,
-		RecipientID: recipientID,
-		Timestamp:   timestamp,
+		Type:      TransactionTypeMultisignatureRegistration,
+		Amount:    0,
+		Timestamp: timestamp,
+		Network:   net,
 		Asset: &RegisterMultisignatureAccountAsset{
 			AddKeys:    addKeys,
 			RemoveKeys: removeKeys,
@@ -170,17 +168,176 @@ func NewMultisignatureRegistrationTransaction(recipientID string, secret string,
 		return nil, err
 	}
 
-	pubKey := crypto.GetPublicKeyFromSecret(secret)
-	transaction.SenderPublicKey = pubKey
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// NewDelegateRegistrationTransaction creates a new transaction to register the sender as a delegate with the given
+// username and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
+func NewDelegateRegistrationTransaction(net *network.Network, signer crypto.Signer, secondSigner crypto.Signer,
+	timeOffset int64, username string) (
+	*Transaction, error) {
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	if signer == nil {
+		return nil, errors.New("signer must not be nil")
+	}
+
+	transaction := &Transaction{
+		Type:      TransactionTypeDelegateRegistration,
+		Amount:    0,
+		Timestamp: timestamp,
+		Network:   net,
+		Asset: &RegisterDelegateAsset{
+			Username:  username,
+			PublicKey: signer.PublicKey(),
+		},
+	}
+
+	if valid, err := transaction.Asset.IsValid(); !valid {
+		return nil, err
+	}
+
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// NewDappRegistrationTransaction creates a new transaction to register the given Dapp
+// and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
+func NewDappRegistrationTransaction(net *network.Network, signer crypto.Signer, secondSigner crypto.Signer,
+	timeOffset int64, dapp *Dapp) (
+	*Transaction, error) {
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &Transaction{
+		Type:      TransactionTypeDappRegistration,
+		Amount:    0,
+		Timestamp: timestamp,
+		Network:   net,
+		Asset: &CreateDappAsset{
+			Dapp: dapp,
+		},
+	}
+
+	if valid, err := transaction.Asset.IsValid(); !valid {
+		return nil, err
+	}
+
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// NewTransferInDappTransaction creates a new transaction to transfer lisk into the Dapp with the given ID
+// and signs it using the given signers.
+// The second signer is optional and only required for lisk wallets with a second signature.
+func NewTransferInDappTransaction(net *network.Network, amount uint64, signer crypto.Signer,
+	secondSigner crypto.Signer, timeOffset int64, dappID string) (
+	*Transaction, error) {
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &Transaction{
+		Type:      TransactionTypeTransferInSidechain,
+		Amount:    amount,
+		Timestamp: timestamp,
+		Network:   net,
+		Asset: &TransferInDappAsset{
+			DappID: dappID,
+		},
+	}
+
+	if valid, err := transaction.Asset.IsValid(); !valid {
+		return nil, err
+	}
+
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// NewTransferOutDappTransaction creates a new transaction to transfer lisk out of the Dapp with the given ID
+// to the recipient and signs it using the given signers. It has to be signed by the owner of the Dapp.
+// The second signer is optional and only required for lisk wallets with a second signature.
+// TransactionID is the ID of the withdrawal transaction on the sidechain.
+func NewTransferOutDappTransaction(net *network.Network, recipientID string, amount uint64, signer crypto.Signer,
+	secondSigner crypto.Signer, timeOffset int64, dappID string, transactionID string) (
+	*Transaction, error) {
+	timestamp, err := getNetworkTimestamp(net, timeOffset)
+	if err != nil {
+		return nil, err
+	}
 
-	privKey := crypto.GetPrivateKeyFromSecret(secret)
-	transaction.Sign(privKey)
+	transaction := &Transaction{
+		Type:        TransactionTypeTransferOutSidechain,
+		Amount:      amount,
+		RecipientID: recipientID,
+		Timestamp:   timestamp,
+		Network:     net,
+		Asset: &TransferOutDappAsset{
+			DappID:        dappID,
+			TransactionID: transactionID,
+		},
+	}
+
+	if valid, err := transaction.Asset.IsValid(); !valid {
+		return nil, err
+	}
 
-	// Add a second signature if a second secret is given
-	if secondSecret != "" {
-		privKey2 := crypto.GetPrivateKeyFromSecret(secondSecret)
-		transaction.SecondSign(privKey2)
+	if err := transaction.signWith(signer, secondSigner); err != nil {
+		return nil, err
 	}
 
 	return transaction, nil
 }
+
+// getNetworkTimestamp returns the current blockchain time of the network with an offset
+func getNetworkTimestamp(net *network.Network, timeOffset int64) (uint32, error) {
+	if net == nil {
+		return 0, errors.New("network must not be nil")
+	}
+	return GetCurrentNetworkTimeWithOffset(net, timeOffset), nil
+}
+
+// signWith sets the sender's public key and signs the transaction using the given signers.
+// The second signer is optional.
+func (t *Transaction) signWith(signer crypto.Signer, secondSigner crypto.Signer) error {
+	if signer == nil {
+		return errors.New("signer must not be nil")
+	}
+
+	t.SenderPublicKey = signer.PublicKey()
+
+	if err := t.Sign(signer); err != nil {
+		return err
+	}
+
+	// Add a second signature if a second signer is given
+	if secondSigner != nil {
+		if err := t.SecondSign(secondSigner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
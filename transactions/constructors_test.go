@@ -1,49 +1,141 @@
 package transactions
 
-import "testing"
+import (
+	"bytes"
+	"testing"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+)
+
+var (
+	defaultSigner       = crypto.NewPassphraseSigner("")
+	defaultSecondSigner = crypto.NewPassphraseSigner("c")
+)
 
 func TestNewTransaction(t *testing.T) {
-	if _, err := NewTransaction("", 0, "", "c", 0); err != nil {
+	if _, err := NewTransaction(network.Testnet, defaultRecipient, 1, defaultSigner, defaultSecondSigner, 0); err != nil {
 		t.Errorf("NewTransaction() returns error: %v, nil; expected transaction", err)
 	}
+
+	if val, err := NewTransaction(network.Testnet, "", 1, defaultSigner, nil, 0); err == nil {
+		t.Errorf("NewTransaction(no recipient) returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewTransaction(network.Testnet, defaultRecipient, 0, defaultSigner, nil, 0); err == nil {
+		t.Errorf("NewTransaction(0 amount) returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewTransaction(nil, "", 0, defaultSigner, nil, 0); err == nil {
+		t.Errorf("NewTransaction(nil network) returns wrong data: %v, nil; expected error", val)
+	}
 }
 
 func TestNewTransactionWithData(t *testing.T) {
-	if _, err := NewTransactionWithData("", 0, "", "c", 0, "abc"); err != nil {
+	if _, err := NewTransactionWithData(network.Testnet, defaultRecipient, 1, defaultSigner, defaultSecondSigner, 0, "abc"); err != nil {
 		t.Errorf("NewTransactionWithData() returns error: %v, nil; expected transaction", err)
 	}
 
-	if _, err := NewTransactionWithData("", 0, "", "c", 0, []byte("abc")); err != nil {
+	if _, err := NewTransactionWithData(network.Testnet, defaultRecipient, 1, defaultSigner, defaultSecondSigner, 0, []byte("abc")); err != nil {
 		t.Errorf("NewTransactionWithData() returns error: %v, nil; expected transaction", err)
 	}
 
-	if val, err := NewTransactionWithData("", 0, "", "c", 0, 0); err == nil {
+	if val, err := NewTransactionWithData(network.Testnet, defaultRecipient, 1, defaultSigner, defaultSecondSigner, 0, 0); err == nil {
 		t.Errorf("NewTransactionWithData() returns wrong data: %v, nil; expected error", val)
 	}
 }
 
 func TestNewSecondSignatureTransaction(t *testing.T) {
-	if _, err := NewSecondSignatureTransaction("", "", "abc", 0); err != nil {
+	if _, err := NewSecondSignatureTransaction(network.Testnet, defaultSigner, crypto.GetPublicKeyFromSecret("abc"), 0); err != nil {
 		t.Errorf("NewSecondSignatureTransaction() returns error: %v, nil; expected transaction", err)
 	}
 }
 
 func TestNewVoteTransaction(t *testing.T) {
-	if _, err := NewVoteTransaction("", "", "c", 0, [][]byte{defaultSenderPublicKey}, [][]byte{}); err != nil {
+	address := crypto.GetAddressFromPublicKey(defaultSigner.PublicKey())
+
+	if val, err := NewVoteTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, [][]byte{defaultSenderPublicKey}, [][]byte{}); err != nil {
 		t.Errorf("NewVoteTransaction() returns error: %v, nil; expected transaction", err)
+	} else if val.RecipientID != address {
+		t.Errorf("NewVoteTransaction() returns recipient %s; expected the address of the signer %s", val.RecipientID, address)
 	}
 
-	if val, err := NewVoteTransaction("", "", "c", 0, [][]byte{[]byte("abc")}, [][]byte{}); err == nil {
+	if val, err := NewVoteTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, [][]byte{[]byte("abc")}, [][]byte{}); err == nil {
 		t.Errorf("NewVoteTransaction() returns wrong data: %v, nil; expected error", val)
 	}
+
+	if val, err := NewVoteTransaction(network.Testnet, nil, nil, 0, [][]byte{defaultSenderPublicKey}, [][]byte{}); err == nil {
+		t.Errorf("NewVoteTransaction(no signer) returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewVoteTransaction(network.Testnet, defaultSigner, nil, 0, [][]byte{}, [][]byte{}); err == nil {
+		t.Errorf("NewVoteTransaction(no votes) returns wrong data: %v, nil; expected error", val)
+	}
 }
 
 func TestNewMultisignatureRegistrationTransaction(t *testing.T) {
-	if _, err := NewMultisignatureRegistrationTransaction("", "", "c", 0, [][]byte{defaultSenderPublicKey}, [][]byte{}, 0, 0); err != nil {
+	if _, err := NewMultisignatureRegistrationTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, [][]byte{defaultMultisignaturePublicKey}, [][]byte{}, 24, 1); err != nil {
 		t.Errorf("NewMultisignatureRegistrationTransaction() returns error: %v, nil; expected transaction", err)
 	}
 
-	if val, err := NewMultisignatureRegistrationTransaction("", "", "c", 0, [][]byte{[]byte("abc")}, [][]byte{}, 0, 0); err == nil {
+	if val, err := NewMultisignatureRegistrationTransaction(network.Testnet, defaultSigner, nil, 0, [][]byte{defaultSigner.PublicKey()}, [][]byte{}, 24, 1); err == nil {
+		t.Errorf("NewMultisignatureRegistrationTransaction(sender key) returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewMultisignatureRegistrationTransaction(network.Testnet, defaultSigner, nil, 0, [][]byte{defaultMultisignaturePublicKey}, [][]byte{defaultSenderSecondPublicKey}, 24, 1); err == nil {
+		t.Errorf("NewMultisignatureRegistrationTransaction(remove keys) returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewMultisignatureRegistrationTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, [][]byte{[]byte("abc")}, [][]byte{}, 0, 0); err == nil {
 		t.Errorf("NewMultisignatureRegistrationTransaction() returns wrong data: %v, nil; expected error", val)
 	}
 }
+
+func TestNewDelegateRegistrationTransaction(t *testing.T) {
+	transaction, err := NewDelegateRegistrationTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, "genesis_1")
+	if err != nil {
+		t.Fatalf("NewDelegateRegistrationTransaction() returns error: %v, nil; expected transaction", err)
+	}
+	if asset, ok := transaction.Asset.(*RegisterDelegateAsset); !ok || !bytes.Equal(asset.PublicKey, defaultSigner.PublicKey()) {
+		t.Errorf("NewDelegateRegistrationTransaction() returns wrong asset: %v", transaction.Asset)
+	}
+
+	if val, err := NewDelegateRegistrationTransaction(network.Testnet, defaultSigner, nil, 0, "1234L"); err == nil {
+		t.Errorf("NewDelegateRegistrationTransaction() returns wrong data: %v, nil; expected error", val)
+	}
+
+	if val, err := NewDelegateRegistrationTransaction(network.Testnet, nil, nil, 0, "genesis_1"); err == nil {
+		t.Errorf("NewDelegateRegistrationTransaction(nil signer) returns wrong data: %v, nil; expected error", val)
+	}
+}
+
+func TestNewDappRegistrationTransaction(t *testing.T) {
+	dapp := &Dapp{Name: "Lisk Guestbook", Link: "https://a.io/a.zip", Category: DappCategorySocial}
+	if _, err := NewDappRegistrationTransaction(network.Testnet, defaultSigner, defaultSecondSigner, 0, dapp); err != nil {
+		t.Errorf("NewDappRegistrationTransaction() returns error: %v, nil; expected transaction", err)
+	}
+
+	if val, err := NewDappRegistrationTransaction(network.Testnet, defaultSigner, nil, 0, &Dapp{Name: "a"}); err == nil {
+		t.Errorf("NewDappRegistrationTransaction() returns wrong data: %v, nil; expected error", val)
+	}
+}
+
+func TestNewTransferInDappTransaction(t *testing.T) {
+	if _, err := NewTransferInDappTransaction(network.Testnet, 1000, defaultSigner, defaultSecondSigner, 0, defaultAppId); err != nil {
+		t.Errorf("NewTransferInDappTransaction() returns error: %v, nil; expected transaction", err)
+	}
+
+	if val, err := NewTransferInDappTransaction(network.Testnet, 0, defaultSigner, nil, 0, defaultAppId); err == nil {
+		t.Errorf("NewTransferInDappTransaction(0 amount) returns wrong data: %v, nil; expected error", val)
+	}
+}
+
+func TestNewTransferOutDappTransaction(t *testing.T) {
+	if _, err := NewTransferOutDappTransaction(network.Testnet, defaultRecipient, 1000, defaultSigner, defaultSecondSigner, 0, defaultAppId, defaultTransactionId); err != nil {
+		t.Errorf("NewTransferOutDappTransaction() returns error: %v, nil; expected transaction", err)
+	}
+
+	if val, err := NewTransferOutDappTransaction(network.Testnet, "", 1000, defaultSigner, nil, 0, defaultAppId, defaultTransactionId); err == nil {
+		t.Errorf("NewTransferOutDappTransaction(no recipient) returns wrong data: %v, nil; expected error", val)
+	}
+}
@@ -0,0 +1,301 @@
+package transactions
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// binaryLayout describes which of the optional fields are present in a serialized transaction
+	binaryLayout struct {
+		hasRequesterPublicKey bool
+		signatures            int
+	}
+)
+
+var (
+	// binaryLayouts are all possible layouts of a serialized transaction, most common first
+	binaryLayouts = []binaryLayout{
+		{hasRequesterPublicKey: false, signatures: 1},
+		{hasRequesterPublicKey: false, signatures: 2},
+		{hasRequesterPublicKey: true, signatures: 1},
+		{hasRequesterPublicKey: true, signatures: 2},
+		{hasRequesterPublicKey: false, signatures: 0},
+		{hasRequesterPublicKey: true, signatures: 0},
+	}
+)
+
+// Deserialize parses a transaction from the binary format produced by Serialize.
+//
+// The binary format neither encodes the length of the asset nor whether a requester public key or a second
+// signature is present. Deserialize tries all possible layouts and picks the one whose signature verifies
+// against the sender's (or requester's) public key. If no signature verifies, the first layout that results
+// in a valid transaction is used.
+//
+// The assets of dapp registrations and sidechain withdrawals consist of concatenated strings which
+// cannot be separated again. These transactions can only be parsed using UnmarshalJSON.
+func (t *Transaction) Deserialize(data []byte) error {
+	// Copy the data so the transaction doesn't share memory with the caller
+	data = append([]byte(nil), data...)
+
+	var fallback *Transaction
+	var firstErr error
+	for _, layout := range binaryLayouts {
+		transaction, err := deserializeWithLayout(data, layout)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if layout.signatures > 0 {
+			if valid, _ := transaction.Verify(); valid {
+				transaction.Network = t.Network
+				*t = *transaction
+				return nil
+			}
+		}
+
+		if fallback == nil {
+			fallback = transaction
+		}
+	}
+
+	if fallback == nil {
+		return fmt.Errorf("cannot deserialize: %v", firstErr)
+	}
+
+	fallback.Network = t.Network
+	*t = *fallback
+	return nil
+}
+
+func deserializeWithLayout(data []byte, layout binaryLayout) (*Transaction, error) {
+	headerSize := 1 + byteSizeTimestamp + ed25519.PublicKeySize + byteSizeRecipientID + byteSizeAmount
+	if layout.hasRequesterPublicKey {
+		headerSize += ed25519.PublicKeySize
+	}
+
+	assetEnd := len(data) - layout.signatures*byteSizeSignatureTransaction
+	if assetEnd < headerSize {
+		return nil, errors.New("data is too short")
+	}
+
+	transaction := &Transaction{}
+	offset := 0
+
+	// First byte is the transaction type
+	transaction.Type = TransactionType(data[offset])
+	offset++
+
+	transaction.Timestamp = binary.LittleEndian.Uint32(data[offset:])
+	offset += byteSizeTimestamp
+
+	transaction.SenderPublicKey = data[offset : offset+ed25519.PublicKeySize]
+	offset += ed25519.PublicKeySize
+
+	if layout.hasRequesterPublicKey {
+		transaction.TransactionRequesterPublicKey = data[offset : offset+ed25519.PublicKeySize]
+		offset += ed25519.PublicKeySize
+	}
+
+	// An empty recipient is serialized as zeros
+	numericAddress := new(big.Int).SetBytes(data[offset : offset+byteSizeRecipientID])
+	if numericAddress.Sign() != 0 {
+		transaction.RecipientID = numericAddress.Text(10) + "L"
+	}
+	offset += byteSizeRecipientID
+
+	transaction.Amount = binary.LittleEndian.Uint64(data[offset:])
+	offset += byteSizeAmount
+
+	asset, err := deserializeAsset(transaction, data[offset:assetEnd])
+	if err != nil {
+		return nil, err
+	}
+	transaction.Asset = asset
+
+	if layout.signatures > 0 {
+		transaction.signature = data[assetEnd : assetEnd+byteSizeSignatureTransaction]
+	}
+	if layout.signatures > 1 {
+		transaction.secondSignature = data[assetEnd+byteSizeSignatureTransaction:]
+	}
+
+	if valid, err := transaction.IsValid(); !valid {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// deserializeAsset parses the binary asset data of a transaction
+func deserializeAsset(t *Transaction, data []byte) (Asset, error) {
+	switch t.Type {
+	case TransactionTypeNormal:
+		if len(data) == 0 {
+			return nil, nil
+		}
+		return DataAsset(data), nil
+	case TransactionTypeSecondSecretRegistration:
+		return &RegisterSecondSignatureAsset{PublicKey: data}, nil
+	case TransactionTypeDelegateRegistration:
+		return &RegisterDelegateAsset{Username: string(data), PublicKey: t.SenderPublicKey}, nil
+	case TransactionTypeVote:
+		entries, err := splitSignedPublicKeys(data)
+		if err != nil {
+			return nil, err
+		}
+
+		votes, unvotes, err := parseSignedPublicKeys(entries)
+		if err != nil {
+			return nil, err
+		}
+
+		return &CastVoteAsset{Votes: votes, Unvotes: unvotes}, nil
+	case TransactionTypeMultisignatureRegistration:
+		if len(data) < 2 {
+			return nil, errors.New("multisignature asset is too short")
+		}
+
+		entries, err := splitSignedPublicKeys(data[2:])
+		if err != nil {
+			return nil, err
+		}
+
+		addKeys, removeKeys, err := parseSignedPublicKeys(entries)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RegisterMultisignatureAccountAsset{
+			Min:        data[0],
+			Lifetime:   data[1],
+			AddKeys:    addKeys,
+			RemoveKeys: removeKeys,
+		}, nil
+	case TransactionTypeTransferInSidechain:
+		return &TransferInDappAsset{DappID: string(data)}, nil
+	}
+
+	return nil, fmt.Errorf("the asset of transaction type %d cannot be recovered from the binary format", t.Type)
+}
+
+// UnmarshalJSON parses a transaction from the JSON payload produced by MarshalJSON or returned by the node
+func (t *Transaction) UnmarshalJSON(data []byte) error {
+	payload := &deserializableTransaction{}
+	if err := json.Unmarshal(data, payload); err != nil {
+		return err
+	}
+
+	transaction := &Transaction{
+		Type:        payload.Type,
+		Amount:      payload.Amount,
+		RecipientID: payload.RecipientID,
+		Timestamp:   payload.Timestamp,
+	}
+
+	var err error
+	if transaction.SenderPublicKey, err = decodeOptionalHex(payload.SenderPublicKey); err != nil {
+		return fmt.Errorf("invalid senderPublicKey: %v", err)
+	}
+
+	requesterPublicKey := payload.TransactionRequesterPublicKey
+	if requesterPublicKey == "" {
+		requesterPublicKey = payload.RequesterPublicKey
+	}
+	if transaction.TransactionRequesterPublicKey, err = decodeOptionalHex(requesterPublicKey); err != nil {
+		return fmt.Errorf("invalid requesterPublicKey: %v", err)
+	}
+
+	if transaction.signature, err = decodeOptionalHex(payload.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %v", err)
+	}
+
+	secondSignature := payload.SecondSignature
+	if secondSignature == "" {
+		secondSignature = payload.SignSignature
+	}
+	if transaction.secondSignature, err = decodeOptionalHex(secondSignature); err != nil {
+		return fmt.Errorf("invalid signSignature: %v", err)
+	}
+
+	for _, signature := range payload.Signatures {
+		decodedSignature, err := hex.DecodeString(signature)
+		if err != nil {
+			return fmt.Errorf("invalid signatures: %v", err)
+		}
+		transaction.signatures = append(transaction.signatures, decodedSignature)
+	}
+
+	if transaction.Asset, err = UnmarshalAsset(transaction.Type, payload.Asset); err != nil {
+		return fmt.Errorf("invalid asset: %v", err)
+	}
+
+	// The delegate public key is always the public key of the sender
+	if delegateAsset, ok := transaction.Asset.(*RegisterDelegateAsset); ok && len(delegateAsset.PublicKey) == 0 {
+		delegateAsset.PublicKey = transaction.SenderPublicKey
+	}
+
+	if valid, err := transaction.IsValid(); !valid {
+		return fmt.Errorf("cannot unmarshal: %v", err)
+	}
+
+	transaction.Network = t.Network
+	*t = *transaction
+	return nil
+}
+
+// UnmarshalAsset parses the JSON asset of a transaction into the asset type matching the transaction type.
+// The node omits the public key of delegate registrations; it is the sender's public key.
+func UnmarshalAsset(transactionType TransactionType, data []byte) (Asset, error) {
+	if len(data) == 0 || string(data) == "null" {
+		data = []byte("{}")
+	}
+
+	var asset Asset
+	switch transactionType {
+	case TransactionTypeNormal:
+		// Normal transactions only have an asset if data is attached
+		payload := &struct {
+			Data *string `json:"data"`
+		}{}
+		if err := json.Unmarshal(data, payload); err != nil {
+			return nil, err
+		}
+
+		if payload.Data == nil {
+			return nil, nil
+		}
+		return DataAsset(*payload.Data), nil
+	case TransactionTypeSecondSecretRegistration:
+		asset = &RegisterSecondSignatureAsset{}
+	case TransactionTypeDelegateRegistration:
+		asset = &RegisterDelegateAsset{}
+	case TransactionTypeVote:
+		asset = &CastVoteAsset{}
+	case TransactionTypeMultisignatureRegistration:
+		asset = &RegisterMultisignatureAccountAsset{}
+	case TransactionTypeDappRegistration:
+		asset = &CreateDappAsset{}
+	case TransactionTypeTransferInSidechain:
+		asset = &TransferInDappAsset{}
+	case TransactionTypeTransferOutSidechain:
+		asset = &TransferOutDappAsset{}
+	default:
+		return nil, fmt.Errorf("unknown transaction type %d", transactionType)
+	}
+
+	if err := json.Unmarshal(data, asset); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
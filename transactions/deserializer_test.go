@@ -0,0 +1,217 @@
+package transactions
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/liskascend/lisk-go/crypto"
+)
+
+var (
+	defaultSecret       = "secret"
+	defaultSecondSecret = "second secret"
+	defaultDappID       = "1234213"
+)
+
+func signedTestTransactions() []*Transaction {
+	testTransactions := []*Transaction{
+		{Type: TransactionTypeNormal, Amount: 1000, RecipientID: defaultRecipient},
+		{Type: TransactionTypeNormal, Amount: 1000, RecipientID: defaultRecipient, Asset: DataAsset("Hello Lisk!")},
+		{Type: TransactionTypeSecondSecretRegistration, Asset: &RegisterSecondSignatureAsset{PublicKey: defaultSenderSecondPublicKey}},
+		{Type: TransactionTypeDelegateRegistration, Asset: &RegisterDelegateAsset{Username: "genesis_1", PublicKey: defaultSenderPublicKey}},
+		{Type: TransactionTypeVote, RecipientID: defaultSenderId, Asset: &CastVoteAsset{
+			Votes:   [][]byte{defaultSenderPublicKey},
+			Unvotes: [][]byte{defaultSenderSecondPublicKey},
+		}},
+		{Type: TransactionTypeMultisignatureRegistration, Asset: &RegisterMultisignatureAccountAsset{
+			Min:      2,
+			Lifetime: 24,
+			AddKeys:  [][]byte{defaultMultisignaturePublicKey, defaultSenderSecondPublicKey},
+		}},
+		{Type: TransactionTypeTransferInSidechain, Amount: 1000, Asset: &TransferInDappAsset{DappID: defaultDappID}},
+	}
+
+	signer := crypto.NewPassphraseSigner(defaultSecret)
+	secondSigner := crypto.NewPassphraseSigner(defaultSecondSecret)
+
+	var result []*Transaction
+	for _, transaction := range testTransactions {
+		transaction.Timestamp = uint32(defaultTimestamp)
+		transaction.SenderPublicKey = defaultSenderPublicKey
+		transaction.Sign(signer)
+		result = append(result, transaction)
+
+		// Add a copy with a second signature
+		secondSigned := *transaction
+		secondSigned.SecondSign(secondSigner)
+		result = append(result, &secondSigned)
+	}
+
+	return result
+}
+
+func TestTransaction_Deserialize(t *testing.T) {
+	for i, transaction := range signedTestTransactions() {
+		data, err := transaction.Serialize()
+		if err != nil {
+			t.Fatalf("#%d: Transaction.Serialize() returns error: %v", i, err)
+		}
+
+		result := &Transaction{}
+		if err := result.Deserialize(data); err != nil {
+			t.Errorf("#%d: Transaction.Deserialize(%v) returns error: %v", i, data, err)
+			continue
+		}
+
+		if !bytes.Equal(result.signature, transaction.signature) ||
+			!bytes.Equal(result.secondSignature, transaction.secondSignature) {
+			t.Errorf("#%d: Transaction.Deserialize() returns wrong signatures: %v,%v; want %v,%v", i,
+				result.signature, result.secondSignature, transaction.signature, transaction.secondSignature)
+		}
+
+		if val, err := result.Serialize(); !bytes.Equal(val, data) || err != nil {
+			t.Errorf("#%d: Transaction.Deserialize() does not round-trip: %v,%v; want %v", i, val, err, data)
+		}
+	}
+}
+
+func TestTransaction_DeserializeWithRequesterPublicKey(t *testing.T) {
+	requesterSecret := "requester"
+	transaction := &Transaction{
+		Type:                          TransactionTypeNormal,
+		Amount:                        uint64(defaultAmount),
+		RecipientID:                   defaultRecipient,
+		Timestamp:                     uint32(defaultTimestamp),
+		SenderPublicKey:               defaultSenderPublicKey,
+		TransactionRequesterPublicKey: crypto.GetPublicKeyFromSecret(requesterSecret),
+	}
+	transaction.Sign(crypto.NewPassphraseSigner(requesterSecret))
+
+	data, _ := transaction.Serialize()
+	result := &Transaction{}
+	if err := result.Deserialize(data); err != nil || !bytes.Equal(result.TransactionRequesterPublicKey, transaction.TransactionRequesterPublicKey) {
+		t.Errorf("Transaction.Deserialize() returns wrong requester public key: %v,%v; want %v", result.TransactionRequesterPublicKey, err, transaction.TransactionRequesterPublicKey)
+	}
+}
+
+func TestTransaction_DeserializeReferenceVector(t *testing.T) {
+	data, _ := base64.StdEncoding.DecodeString("BKopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAAACBSs2ZTMwNDY0YzRkOWRhZWE4MzQ2NTZhOTY0YTBkMzlkYmJiOWEwOWM5YmIxY2MwNTYxOTQzMzMyMDFlZjgwZWUyKzA0MDFjOGFjOWYyOWRlZDllMWU0ZDViNmI0MzA1MWNiMjViMjJmMjdjN2I3YjM1MDkyMTYxZTg1MTk0NmY4MmZhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK")
+
+	result := &Transaction{}
+	if err := result.Deserialize(data); err != nil {
+		t.Fatalf("Transaction.Deserialize() returns error: %v", err)
+	}
+
+	asset, ok := result.Asset.(*RegisterMultisignatureAccountAsset)
+	if !ok || asset.Min != 2 || asset.Lifetime != 5 || len(asset.AddKeys) != 2 ||
+		!bytes.Equal(asset.AddKeys[1], defaultSenderSecondPublicKey) {
+		t.Errorf("Transaction.Deserialize() returns wrong asset: %v", result.Asset)
+	}
+
+	if result.Timestamp != uint32(defaultTimestamp) || !bytes.Equal(result.signature, defaultSignature) ||
+		result.RecipientID != "" {
+		t.Errorf("Transaction.Deserialize() returns wrong data: %v", result)
+	}
+}
+
+func TestTransaction_DeserializeInvalid(t *testing.T) {
+	if err := (&Transaction{}).Deserialize([]byte{0, 1, 2}); err == nil {
+		t.Errorf("Transaction.Deserialize(too short) returns no error; expected error")
+	}
+
+	transaction := &Transaction{
+		Type:            TransactionTypeTransferOutSidechain,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+		RecipientID:     defaultRecipient,
+		Asset:           &TransferOutDappAsset{DappID: defaultDappID, TransactionID: defaultTransactionId},
+	}
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+	data, _ := transaction.Serialize()
+
+	if err := (&Transaction{}).Deserialize(data); err == nil {
+		t.Errorf("Transaction.Deserialize(outTransfer) returns no error; expected error")
+	}
+}
+
+func TestTransaction_UnmarshalJSON(t *testing.T) {
+	testTransactions := append(signedTestTransactions(),
+		&Transaction{
+			Type:            TransactionTypeDappRegistration,
+			Timestamp:       uint32(defaultTimestamp),
+			SenderPublicKey: defaultSenderPublicKey,
+			signature:       defaultSignature,
+			Asset: &CreateDappAsset{Dapp: &Dapp{
+				Name:     "Lisk Guestbook",
+				Link:     "https://github.com/MaxKK/guestbookDapp/archive/master.zip",
+				Category: 1,
+				Tags:     "guestbook,message",
+			}},
+		},
+		&Transaction{
+			Type:            TransactionTypeTransferOutSidechain,
+			Amount:          uint64(defaultAmount),
+			RecipientID:     defaultRecipient,
+			Timestamp:       uint32(defaultTimestamp),
+			SenderPublicKey: defaultSenderPublicKey,
+			signature:       defaultSignature,
+			Asset:           &TransferOutDappAsset{DappID: defaultDappID, TransactionID: defaultTransactionId},
+		})
+
+	for i, transaction := range testTransactions {
+		data, err := json.Marshal(transaction)
+		if err != nil {
+			t.Fatalf("#%d: Transaction.MarshalJSON() returns error: %v", i, err)
+		}
+
+		result := &Transaction{}
+		if err := json.Unmarshal(data, result); err != nil {
+			t.Errorf("#%d: Transaction.UnmarshalJSON(%s) returns error: %v", i, data, err)
+			continue
+		}
+
+		if val, err := json.Marshal(result); string(val) != string(data) || err != nil {
+			t.Errorf("#%d: Transaction.UnmarshalJSON() does not round-trip: %s,%v; want %s", i, val, err, data)
+		}
+	}
+}
+
+func TestTransaction_UnmarshalJSONNodePayload(t *testing.T) {
+	payload := `{"id":"13987348420913138422","amount":"0","fee":"2500000000","type":2,"timestamp":141738,` +
+		`"senderId":"18160565574430594874L","senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09",` +
+		`"recipientId":null,"signature":"618a54975212ead93df8c881655c625544bce8ed7ccdfe6f08a42eecfb1adebd051307be5014bb051617baf7815d50f62129e70918190361e5d4dd4796541b0a",` +
+		`"signSignature":"b00c4ad1988bca245d74435660a278bfe6bf2f5efa8bda96d927fabf8b4f6fcfdcb2953f6abacaa119d6880987a55dea0e6354bc8366052b45fa23145522020f",` +
+		`"signatures":[],"confirmations":10,"asset":{"delegate":{"username":"genesis_1"}}}`
+
+	result := &Transaction{}
+	if err := json.Unmarshal([]byte(payload), result); err != nil {
+		t.Fatalf("Transaction.UnmarshalJSON() returns error: %v", err)
+	}
+
+	asset, ok := result.Asset.(*RegisterDelegateAsset)
+	if !ok || asset.Username != "genesis_1" || !bytes.Equal(asset.PublicKey, defaultSenderPublicKey) {
+		t.Errorf("Transaction.UnmarshalJSON() returns wrong asset: %v", result.Asset)
+	}
+
+	if !bytes.Equal(result.signature, defaultSignature) || !bytes.Equal(result.secondSignature, defaultSecondSignature) {
+		t.Errorf("Transaction.UnmarshalJSON() returns wrong signatures: %v,%v", result.signature, result.secondSignature)
+	}
+}
+
+func TestTransaction_UnmarshalJSONInvalid(t *testing.T) {
+	payloads := []string{
+		`{"type":0,"amount":"1","senderPublicKey":"xyz","asset":{}}`,
+		`{"type":0,"amount":"1","senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09","signature":"abc","asset":{}}`,
+		`{"type":1,"amount":"0","senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09","asset":{}}`,
+		`{"type":3,"amount":"0","senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09","asset":{"votes":["*abc"]}}`,
+		`{"type":9,"amount":"0","senderPublicKey":"5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09","asset":{}}`,
+	}
+
+	for i, payload := range payloads {
+		if err := json.Unmarshal([]byte(payload), &Transaction{}); err == nil {
+			t.Errorf("#%d: Transaction.UnmarshalJSON(%s) returns no error; expected error", i, payload)
+		}
+	}
+}
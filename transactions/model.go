@@ -1,10 +1,18 @@
 package transactions
 
+import (
+	"encoding/json"
+
+	"github.com/liskascend/lisk-go/network"
+)
+
 type (
 	// TransactionType represents a transaction type and specifies the associated action
 	TransactionType byte
 
-	// Transaction represents a lisk network transaction
+	// Transaction represents a lisk network transaction.
+	// Network is the network the transaction is created for and determines its fee.
+	// The mainnet is assumed if it's nil.
 	Transaction struct {
 		Type                          TransactionType
 		Amount                        uint64
@@ -13,8 +21,10 @@ type (
 		Asset                         Asset
 		SenderPublicKey               []byte
 		TransactionRequesterPublicKey []byte
+		Network                       *network.Network
 		signature                     []byte
 		secondSignature               []byte
+		signatures                    [][]byte
 	}
 
 	// serializableTransaction is a transaction model that can be serialized to JSON
@@ -23,7 +33,7 @@ type (
 		ID                            string          `json:"id"`
 		SenderID                      string          `json:"senderId"`
 		Amount                        uint64          `json:"amount,string"`
-		Fee                           int             `json:"fee,string"`
+		Fee                           uint64          `json:"fee,string"`
 		RecipientID                   string          `json:"recipientId"`
 		Timestamp                     uint32          `json:"timestamp"`
 		Asset                         interface{}     `json:"asset"`
@@ -31,23 +41,49 @@ type (
 		TransactionRequesterPublicKey string          `json:"transactionRequesterPublicKey,omitempty"`
 		Signature                     string          `json:"signature,omitempty"`
 		SecondSignature               string          `json:"secondSignature,omitempty"`
+		Signatures                    []string        `json:"signatures,omitempty"`
+	}
+
+	// deserializableTransaction is a transaction model that can be deserialized from JSON.
+	// It accepts the field names used by this library as well as the ones returned by the node.
+	deserializableTransaction struct {
+		Type                          TransactionType `json:"type"`
+		Amount                        uint64          `json:"amount,string"`
+		RecipientID                   string          `json:"recipientId"`
+		Timestamp                     uint32          `json:"timestamp"`
+		Asset                         json.RawMessage `json:"asset"`
+		SenderPublicKey               string          `json:"senderPublicKey"`
+		TransactionRequesterPublicKey string          `json:"transactionRequesterPublicKey"`
+		RequesterPublicKey            string          `json:"requesterPublicKey"`
+		Signature                     string          `json:"signature"`
+		SecondSignature               string          `json:"secondSignature"`
+		SignSignature                 string          `json:"signSignature"`
+		Signatures                    []string        `json:"signatures"`
 	}
 
 	// Asset is asset data that can be attached to a transaction
 	Asset interface {
 		serialize() ([]byte, error)
+		validate() ValidationErrors
 		IsValid() (bool, error)
 	}
 
 	// Dapp represents a Dapp on the Lisk blockchain
 	Dapp struct {
-		Name        string
-		Link        string
-		Type        string
-		Category    string
-		Description string
-		Tags        []string
-		Icon        string
+		// Name is the unique name of the Dapp
+		Name string `json:"name"`
+		// Link is the URL of the zip archive containing the Dapp
+		Link string `json:"link"`
+		// Type is the type of the Dapp
+		Type uint32 `json:"type"`
+		// Category is the category of the Dapp
+		Category uint32 `json:"category"`
+		// Description is an optional description of the Dapp
+		Description string `json:"description,omitempty"`
+		// Tags is an optional comma separated list of tags
+		Tags string `json:"tags,omitempty"`
+		// Icon is an optional URL of the Dapp icon
+		Icon string `json:"icon,omitempty"`
 	}
 )
 
@@ -76,3 +112,23 @@ const (
 	// TransactionTypeTransferOutSidechain is used to transfer lisk out of a sidechain
 	TransactionTypeTransferOutSidechain TransactionType = 7
 )
+
+const (
+	// DappTypeDapp is the type of a regular Dapp
+	DappTypeDapp uint32 = 0
+
+	// DappCategoryEducation is the category of education Dapps
+	DappCategoryEducation uint32 = 0
+	// DappCategoryEntertainment is the category of entertainment Dapps
+	DappCategoryEntertainment uint32 = 1
+	// DappCategoryFinance is the category of finance Dapps
+	DappCategoryFinance uint32 = 2
+	// DappCategoryGames is the category of game Dapps
+	DappCategoryGames uint32 = 3
+	// DappCategoryMiscellaneous is the category of miscellaneous Dapps
+	DappCategoryMiscellaneous uint32 = 4
+	// DappCategorySocial is the category of social Dapps
+	DappCategorySocial uint32 = 5
+	// DappCategoryUtilities is the category of utility Dapps
+	DappCategoryUtilities uint32 = 6
+)
@@ -8,8 +8,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"errors"
-
 	"github.com/liskascend/lisk-go/crypto"
 	"golang.org/x/crypto/ed25519"
 )
@@ -72,64 +70,10 @@ func (t *Transaction) Serialize() ([]byte, error) {
 	return dst.Bytes(), nil
 }
 
-// IsValid returns whether the transaction is valid
+// IsValid returns whether the transaction is valid.
+// The returned error is of type ValidationErrors and contains all violated rules.
 func (t *Transaction) IsValid() (bool, error) {
-	if len(t.SenderPublicKey) != ed25519.PublicKeySize {
-		return false, errors.New("invalid or missing SenderPublicKey")
-	}
-
-	if t.Type > 7 {
-		return false, errors.New("invalid transaction type")
-	}
-
-	// TODO implement further validation from server
-	switch t.Type {
-	case TransactionTypeNormal:
-		if t.Asset != nil {
-			if _, valid := t.Asset.(DataAsset); !valid {
-				return false, errors.New("invalid asset type or missing asset")
-			}
-		}
-	case TransactionTypeSecondSecretRegistration:
-		if _, valid := t.Asset.(*RegisterSecondSignatureAsset); !valid {
-			return false, errors.New("invalid asset type or missing asset")
-		}
-	case TransactionTypeDelegateRegistration:
-		if _, valid := t.Asset.(*RegisterDelegateAsset); !valid {
-			return false, errors.New("invalid asset type or missing asset")
-		}
-		if t.Amount > 0 {
-			return false, errors.New("invalid amount; must be 0")
-		}
-	case TransactionTypeVote:
-		if _, valid := t.Asset.(*CastVoteAsset); !valid {
-			return false, errors.New("invalid asset type or missing asset")
-		}
-	case TransactionTypeMultisignatureRegistration:
-		if _, valid := t.Asset.(*RegisterMultisignatureAccountAsset); !valid {
-			return false, errors.New("invalid asset type or missing asset")
-		}
-	}
-
-	if t.Asset != nil {
-		if val, err := t.Asset.IsValid(); !val {
-			return false, fmt.Errorf("invalid asset: %v", err)
-		}
-	}
-
-	if len(t.signature) != 0 && len(t.signature) != byteSizeSignatureTransaction {
-		return false, errors.New("signature has invalid size")
-	}
-
-	if len(t.secondSignature) != 0 && len(t.secondSignature) != byteSizeSecondSignatureTransaction {
-		return false, errors.New("secondSignature has invalid size")
-	}
-
-	if len(t.TransactionRequesterPublicKey) != 0 && len(t.TransactionRequesterPublicKey) != ed25519.PublicKeySize {
-		return false, errors.New("invalid transactionRequesterPubKey size")
-	}
-
-	return true, nil
+	return validationResult(t.Validate())
 }
 
 // MarshalJSON converts the transaction to a JSON payload that can be sent to the node
@@ -154,7 +98,7 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 		ID:                            id,
 		SenderID:                      crypto.GetAddressFromPublicKey(t.SenderPublicKey),
 		Amount:                        t.Amount,
-		Fee:                           int(fee),
+		Fee:                           fee,
 		RecipientID:                   t.RecipientID,
 		Timestamp:                     t.Timestamp,
 		Asset:                         t.Asset,
@@ -164,6 +108,10 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 		SecondSignature:               hex.EncodeToString(t.secondSignature),
 	}
 
+	for _, signature := range t.signatures {
+		preparedTransaction.Signatures = append(preparedTransaction.Signatures, hex.EncodeToString(signature))
+	}
+
 	// Add an empty asset because it's required
 	if preparedTransaction.Asset == nil {
 		preparedTransaction.Asset = struct{}{}
@@ -7,19 +7,20 @@ import (
 )
 
 var (
-	defaultRecipient                = "58191285901858109L"
-	defaultSenderPublicKey, _       = hex.DecodeString("5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09")
-	defaultSenderId                 = "18160565574430594874L"
-	defaultSenderSecondPublicKey, _ = hex.DecodeString("0401c8ac9f29ded9e1e4d5b6b43051cb25b22f27c7b7b35092161e851946f82f")
-	defaultAmount                   = 1000
-	defaultNoAmount                 = 0
-	defaultTimestamp                = 141738
-	defaultTransactionId            = "13987348420913138422"
-	defaultSignature, _             = hex.DecodeString("618a54975212ead93df8c881655c625544bce8ed7ccdfe6f08a42eecfb1adebd051307be5014bb051617baf7815d50f62129e70918190361e5d4dd4796541b0a")
-	defaultSecondSignature, _       = hex.DecodeString("b00c4ad1988bca245d74435660a278bfe6bf2f5efa8bda96d927fabf8b4f6fcfdcb2953f6abacaa119d6880987a55dea0e6354bc8366052b45fa23145522020f")
-	defaultAppId                    = "1234213"
-	defaultDelegateUsername         = "MyDelegateUsername"
-	defaultRequesterPublicKey, _    = hex.DecodeString("5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09")
+	defaultRecipient                  = "58191285901858109L"
+	defaultSenderPublicKey, _         = hex.DecodeString("5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09")
+	defaultSenderId                   = "18160565574430594874L"
+	defaultSenderSecondPublicKey, _   = hex.DecodeString("0401c8ac9f29ded9e1e4d5b6b43051cb25b22f27c7b7b35092161e851946f82f")
+	defaultAmount                     = 1000
+	defaultNoAmount                   = 0
+	defaultTimestamp                  = 141738
+	defaultTransactionId              = "13987348420913138422"
+	defaultSignature, _               = hex.DecodeString("618a54975212ead93df8c881655c625544bce8ed7ccdfe6f08a42eecfb1adebd051307be5014bb051617baf7815d50f62129e70918190361e5d4dd4796541b0a")
+	defaultSecondSignature, _         = hex.DecodeString("b00c4ad1988bca245d74435660a278bfe6bf2f5efa8bda96d927fabf8b4f6fcfdcb2953f6abacaa119d6880987a55dea0e6354bc8366052b45fa23145522020f")
+	defaultAppId                      = "1234213"
+	defaultDelegateUsername           = "mydelegateusername"
+	defaultRequesterPublicKey, _      = hex.DecodeString("5d036a858ce89f844491762eb89e2bfbd50a4a0a0da658e4b2628b25b117ae09")
+	defaultMultisignaturePublicKey, _ = hex.DecodeString("6e30464c4d9daea834656a964a0d39dbbb9a09c9bb1cc056194333201ef80ee2")
 )
 
 func TestSerializeTransactionType0(t *testing.T) {
@@ -172,7 +173,7 @@ func TestSerializeTransactionType2(t *testing.T) {
 		Asset:           &RegisterDelegateAsset{Username: defaultDelegateUsername, PublicKey: defaultSenderPublicKey},
 	}
 
-	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "AqopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAABNeURlbGVnYXRlVXNlcm5hbWVhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK" || err != nil {
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "AqopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAABteWRlbGVnYXRldXNlcm5hbWVhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK" || err != nil {
 		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
 	}
 }
@@ -180,7 +181,7 @@ func TestSerializeTransactionType2(t *testing.T) {
 func TestSerializeTransactionType3(t *testing.T) {
 	transaction := &Transaction{
 		Type:            3,
-		RecipientID:     defaultRecipient,
+		RecipientID:     defaultSenderId,
 		Amount:          uint64(defaultNoAmount),
 		Timestamp:       uint32(defaultTimestamp),
 		SenderPublicKey: defaultSenderPublicKey,
@@ -190,7 +191,7 @@ func TestSerializeTransactionType3(t *testing.T) {
 		},
 	}
 
-	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "A6opAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQDOvKqNNBU9AAAAAAAAAAArNWQwMzZhODU4Y2U4OWY4NDQ0OTE3NjJlYjg5ZTJiZmJkNTBhNGEwYTBkYTY1OGU0YjI2MjhiMjViMTE3YWUwOSswNDAxYzhhYzlmMjlkZWQ5ZTFlNGQ1YjZiNDMwNTFjYjI1YjIyZjI3YzdiN2IzNTA5MjE2MWU4NTE5NDZmODJmYYpUl1IS6tk9+MiBZVxiVUS86O18zf5vCKQu7Psa3r0FEwe+UBS7BRYXuveBXVD2ISnnCRgZA2Hl1N1HllQbCg==" || err != nil {
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "A6opAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCfwHSivQH5c6AAAAAAAAAAArNWQwMzZhODU4Y2U4OWY4NDQ0OTE3NjJlYjg5ZTJiZmJkNTBhNGEwYTBkYTY1OGU0YjI2MjhiMjViMTE3YWUwOSswNDAxYzhhYzlmMjlkZWQ5ZTFlNGQ1YjZiNDMwNTFjYjI1YjIyZjI3YzdiN2IzNTA5MjE2MWU4NTE5NDZmODJmYYpUl1IS6tk9+MiBZVxiVUS86O18zf5vCKQu7Psa3r0FEwe+UBS7BRYXuveBXVD2ISnnCRgZA2Hl1N1HllQbCg==" || err != nil {
 		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
 	}
 }
@@ -205,15 +206,88 @@ func TestSerializeTransactionType4(t *testing.T) {
 		Asset: &RegisterMultisignatureAccountAsset{
 			Min:      2,
 			Lifetime: 5,
-			AddKeys:  [][]byte{defaultSenderPublicKey, defaultSenderSecondPublicKey},
+			AddKeys:  [][]byte{defaultMultisignaturePublicKey, defaultSenderSecondPublicKey},
 		},
 	}
 
-	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "BKopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAAACBSs1ZDAzNmE4NThjZTg5Zjg0NDQ5MTc2MmViODllMmJmYmQ1MGE0YTBhMGRhNjU4ZTRiMjYyOGIyNWIxMTdhZTA5KzA0MDFjOGFjOWYyOWRlZDllMWU0ZDViNmI0MzA1MWNiMjViMjJmMjdjN2I3YjM1MDkyMTYxZTg1MTk0NmY4MmZhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK" || err != nil {
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "BKopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAAACBSs2ZTMwNDY0YzRkOWRhZWE4MzQ2NTZhOTY0YTBkMzlkYmJiOWEwOWM5YmIxY2MwNTYxOTQzMzMyMDFlZjgwZWUyKzA0MDFjOGFjOWYyOWRlZDllMWU0ZDViNmI0MzA1MWNiMjViMjJmMjdjN2I3YjM1MDkyMTYxZTg1MTk0NmY4MmZhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK" || err != nil {
+		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
+	}
+}
+
+func TestSerializeTransactionType5(t *testing.T) {
+	transaction := &Transaction{
+		Type:            5,
+		Amount:          uint64(defaultNoAmount),
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+		signature:       defaultSignature,
+		Asset: &CreateDappAsset{Dapp: &Dapp{
+			Name:        "Lisk Guestbook",
+			Description: "The official Lisk guestbook",
+			Tags:        "guestbook,message,sidechain",
+			Link:        "https://github.com/MaxKK/guestbookDapp/archive/master.zip",
+			Icon:        "https://raw.githubusercontent.com/MaxKK/guestbookDapp/master/icon.png",
+			Type:        DappTypeDapp,
+			Category:    DappCategoryEducation,
+		}},
+	}
+
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "BaopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAAAAAAAAAAAABMaXNrIEd1ZXN0Ym9va1RoZSBvZmZpY2lhbCBMaXNrIGd1ZXN0Ym9va2d1ZXN0Ym9vayxtZXNzYWdlLHNpZGVjaGFpbmh0dHBzOi8vZ2l0aHViLmNvbS9NYXhLSy9ndWVzdGJvb2tEYXBwL2FyY2hpdmUvbWFzdGVyLnppcGh0dHBzOi8vcmF3LmdpdGh1YnVzZXJjb250ZW50LmNvbS9NYXhLSy9ndWVzdGJvb2tEYXBwL21hc3Rlci9pY29uLnBuZwAAAAAAAAAAYYpUl1IS6tk9+MiBZVxiVUS86O18zf5vCKQu7Psa3r0FEwe+UBS7BRYXuveBXVD2ISnnCRgZA2Hl1N1HllQbCg==" || err != nil {
+		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
+	}
+}
+
+func TestSerializeTransactionType6(t *testing.T) {
+	transaction := &Transaction{
+		Type:            6,
+		Amount:          uint64(defaultAmount),
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+		signature:       defaultSignature,
+		Asset:           &TransferInDappAsset{DappID: defaultAppId},
+	}
+
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "BqopAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQAAAAAAAAAA6AMAAAAAAAAxMjM0MjEzYYpUl1IS6tk9+MiBZVxiVUS86O18zf5vCKQu7Psa3r0FEwe+UBS7BRYXuveBXVD2ISnnCRgZA2Hl1N1HllQbCg==" || err != nil {
+		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
+	}
+}
+
+func TestSerializeTransactionType7(t *testing.T) {
+	transaction := &Transaction{
+		Type:            7,
+		Amount:          uint64(defaultAmount),
+		RecipientID:     defaultRecipient,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+		signature:       defaultSignature,
+		Asset:           &TransferOutDappAsset{DappID: defaultAppId, TransactionID: defaultTransactionId},
+	}
+
+	if val, err := transaction.Serialize(); base64.StdEncoding.EncodeToString(val) != "B6opAgBdA2qFjOifhESRdi64niv71QpKCg2mWOSyYoslsReuCQDOvKqNNBU96AMAAAAAAAAxMjM0MjEzMTM5ODczNDg0MjA5MTMxMzg0MjJhilSXUhLq2T34yIFlXGJVRLzo7XzN/m8IpC7s+xrevQUTB75QFLsFFhe694FdUPYhKecJGBkDYeXU3UeWVBsK" || err != nil {
 		t.Errorf("Transaction.Serialize() returns wrong data: %v; error: %v", val, err)
 	}
 }
 
+func TestSerializeTransactionInvalidAmountAndRecipient(t *testing.T) {
+	testTransactions := []*Transaction{
+		{Type: 2, RecipientID: defaultRecipient, Asset: &RegisterDelegateAsset{Username: "abc", PublicKey: defaultSenderPublicKey}},
+		{Type: 5, Amount: uint64(defaultAmount), Asset: &CreateDappAsset{Dapp: &Dapp{Name: "a", Link: "https://a.io/a.zip"}}},
+		{Type: 5, RecipientID: defaultRecipient, Asset: &CreateDappAsset{Dapp: &Dapp{Name: "a", Link: "https://a.io/a.zip"}}},
+		{Type: 6, Asset: &TransferInDappAsset{DappID: defaultAppId}},
+		{Type: 6, Amount: uint64(defaultAmount), RecipientID: defaultRecipient, Asset: &TransferInDappAsset{DappID: defaultAppId}},
+		{Type: 7, RecipientID: defaultRecipient, Asset: &TransferOutDappAsset{DappID: defaultAppId, TransactionID: defaultTransactionId}},
+		{Type: 7, Amount: uint64(defaultAmount), Asset: &TransferOutDappAsset{DappID: defaultAppId, TransactionID: defaultTransactionId}},
+	}
+
+	for i, transaction := range testTransactions {
+		transaction.SenderPublicKey = defaultSenderPublicKey
+		if val, err := transaction.Serialize(); err == nil {
+			t.Errorf("#%d: Transaction.Serialize() returns wrong data: %v, nil; expected error", i, val)
+		}
+	}
+}
+
 func TestMarshalTransaction(t *testing.T) {
 	transaction := &Transaction{
 		Type:            0,
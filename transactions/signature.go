@@ -1,31 +1,201 @@
 package transactions
 
 import (
+	"errors"
+
 	"github.com/liskascend/lisk-go/crypto"
+	"golang.org/x/crypto/ed25519"
 )
 
-// Sign signs the transaction with the given privateKey.
+// Sign signs the transaction using the given signer.
 // This has to be redone when any fields of the transaction are changed.
-func (t *Transaction) Sign(privateKey []byte) error {
-	hash, err := t.Hash()
+func (t *Transaction) Sign(signer crypto.Signer) error {
+	hash, err := t.signatureHash()
 	if err != nil {
 		return err
 	}
 
-	t.signature = crypto.SignMessageWithPrivateKey(string(hash), privateKey)
+	signature, err := signer.Sign(hash)
+	if err != nil {
+		return err
+	}
+	t.signature = signature
 
 	return nil
 }
 
-// SecondSign adds a second signature to the transaction using the given privateKey.
+// SecondSign adds a second signature to the transaction using the given signer of the second secret.
 // This has to be redone when any fields of the transaction are changed.
-func (t *Transaction) SecondSign(privateKey []byte) error {
-	hash, err := t.Hash()
+func (t *Transaction) SecondSign(signer crypto.Signer) error {
+	hash, err := t.secondSignatureHash()
 	if err != nil {
 		return err
 	}
 
-	t.secondSignature = crypto.SignMessageWithPrivateKey(string(hash), privateKey)
+	signature, err := signer.Sign(hash)
+	if err != nil {
+		return err
+	}
+	t.secondSignature = signature
 
 	return nil
 }
+
+// Signature returns the signature of the transaction
+func (t *Transaction) Signature() []byte {
+	return t.signature
+}
+
+// SecondSignature returns the second signature of the transaction
+func (t *Transaction) SecondSignature() []byte {
+	return t.secondSignature
+}
+
+// Signatures returns the signatures of the multisignature account members
+func (t *Transaction) Signatures() [][]byte {
+	return t.signatures
+}
+
+// Multisign signs the transaction as a member of the multisignature account using the given signer.
+// The signature is added to the signatures of the transaction and returned so it can be submitted to the network.
+// The transaction has to be signed by the sender before.
+func (t *Transaction) Multisign(signer crypto.Signer) ([]byte, error) {
+	if len(t.signature) == 0 {
+		return nil, errors.New("transaction is not signed")
+	}
+
+	hash, err := t.signatureHash()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.AddMultisignature(signer.PublicKey(), signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// AddMultisignature adds the signature of a member of the multisignature account to the transaction.
+// The signature is verified against the given public key of the member before it is added.
+func (t *Transaction) AddMultisignature(publicKey, signature []byte) error {
+	if valid, err := t.VerifyMultisignature(publicKey, signature); !valid {
+		if err != nil {
+			return err
+		}
+		return errors.New("invalid multisignature")
+	}
+
+	if containsBytes(t.signatures, signature) {
+		return nil
+	}
+	t.signatures = append(t.signatures, signature)
+
+	return nil
+}
+
+// Verify verifies the signature of the transaction against the SenderPublicKey.
+// If a TransactionRequesterPublicKey is set the signature is verified against that key instead.
+func (t *Transaction) Verify() (bool, error) {
+	if len(t.signature) == 0 {
+		return false, errors.New("transaction is not signed")
+	}
+
+	hash, err := t.signatureHash()
+	if err != nil {
+		return false, err
+	}
+
+	signerPublicKey := t.SenderPublicKey
+	if len(t.TransactionRequesterPublicKey) > 0 {
+		signerPublicKey = t.TransactionRequesterPublicKey
+	}
+
+	return crypto.VerifyDataWithPublicKey(hash, t.signature, signerPublicKey)
+}
+
+// VerifySecondSignature verifies the second signature of the transaction against the given second public key
+// of the sender.
+func (t *Transaction) VerifySecondSignature(secondPublicKey []byte) (bool, error) {
+	if len(t.secondSignature) == 0 {
+		return false, errors.New("transaction has no second signature")
+	}
+
+	if len(secondPublicKey) != ed25519.PublicKeySize {
+		return false, errors.New("invalid second public key size")
+	}
+
+	hash, err := t.secondSignatureHash()
+	if err != nil {
+		return false, err
+	}
+
+	return crypto.VerifyDataWithPublicKey(hash, t.secondSignature, secondPublicKey)
+}
+
+// VerifyMultisignature verifies a signature of a multisignature account member over the transaction.
+func (t *Transaction) VerifyMultisignature(publicKey, signature []byte) (bool, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false, errors.New("invalid public key size")
+	}
+
+	hash, err := t.signatureHash()
+	if err != nil {
+		return false, err
+	}
+
+	return crypto.VerifyDataWithPublicKey(hash, signature, publicKey)
+}
+
+// VerifyMultisignatures returns whether at least min of the given signatures are valid and were created by
+// distinct members of the keysgroup of a multisignature account.
+func (t *Transaction) VerifyMultisignatures(keysgroup [][]byte, min int, signatures [][]byte) (bool, error) {
+	if min <= 0 {
+		return false, errors.New("min must be positive")
+	}
+
+	hash, err := t.signatureHash()
+	if err != nil {
+		return false, err
+	}
+
+	var signers [][]byte
+	for _, signature := range signatures {
+		for _, key := range keysgroup {
+			if containsBytes(signers, key) {
+				continue
+			}
+
+			if valid, _ := crypto.VerifyDataWithPublicKey(hash, signature, key); valid {
+				signers = append(signers, key)
+				break
+			}
+		}
+	}
+
+	return len(signers) >= min, nil
+}
+
+// signatureHash returns the hash of the transaction without any signatures which is signed by the sender
+// and the multisignature members.
+func (t *Transaction) signatureHash() ([]byte, error) {
+	unsigned := *t
+	unsigned.signature = nil
+	unsigned.secondSignature = nil
+
+	return unsigned.Hash()
+}
+
+// secondSignatureHash returns the hash of the transaction including its signature but without the second
+// signature which is signed with the second secret.
+func (t *Transaction) secondSignatureHash() ([]byte, error) {
+	unsigned := *t
+	unsigned.secondSignature = nil
+
+	return unsigned.Hash()
+}
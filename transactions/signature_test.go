@@ -1,9 +1,13 @@
 package transactions
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"testing"
+
+	"github.com/liskascend/lisk-go/crypto"
 )
 
 var (
@@ -11,19 +15,174 @@ var (
 )
 
 func TestTransaction_Sign(t *testing.T) {
-	transaction := Transaction{SenderPublicKey: defaultSenderPublicKey}
-	err := transaction.Sign(defaultPrivateKey)
+	transaction := Transaction{Amount: 1, RecipientID: defaultRecipient, SenderPublicKey: defaultSenderPublicKey}
+	err := transaction.Sign(crypto.NewPrivateKeySigner(defaultPrivateKey))
 
-	if base64.StdEncoding.EncodeToString(transaction.signature) != "dPFiRMXaoW8zAooOemp6sGv9BR6obHnjHOdWmg28n5QzJzs85+sNIvJpLzOxOq3NnCFqbnEChsdzCgMyandbCQ==" || err != nil {
+	if base64.StdEncoding.EncodeToString(transaction.signature) != "BXQ4+9lQwUC7sUgsKDOlwac6tVnL4dbBaQfbG6pyVNLGiWi96Omwk6rfcfqG8PDRoSRIF8849KY+1il6xO7VDQ==" || err != nil {
 		t.Errorf("Transaction.Sign() generates wrong signature: %v; error: %v", base64.StdEncoding.EncodeToString(transaction.signature), err)
 	}
 }
 
 func TestTransaction_SecondSign(t *testing.T) {
-	transaction := Transaction{SenderPublicKey: defaultSenderPublicKey}
-	err := transaction.SecondSign(defaultPrivateKey)
+	transaction := Transaction{Amount: 1, RecipientID: defaultRecipient, SenderPublicKey: defaultSenderPublicKey}
+	err := transaction.SecondSign(crypto.NewPrivateKeySigner(defaultPrivateKey))
 
-	if base64.StdEncoding.EncodeToString(transaction.secondSignature) != "dPFiRMXaoW8zAooOemp6sGv9BR6obHnjHOdWmg28n5QzJzs85+sNIvJpLzOxOq3NnCFqbnEChsdzCgMyandbCQ==" || err != nil {
+	if base64.StdEncoding.EncodeToString(transaction.secondSignature) != "BXQ4+9lQwUC7sUgsKDOlwac6tVnL4dbBaQfbG6pyVNLGiWi96Omwk6rfcfqG8PDRoSRIF8849KY+1il6xO7VDQ==" || err != nil {
 		t.Errorf("Transaction.SecondSign() generates wrong signature: %v; error: %v", base64.StdEncoding.EncodeToString(transaction.signature), err)
 	}
 }
+
+func TestTransaction_Verify(t *testing.T) {
+	transaction := &Transaction{
+		Type:            TransactionTypeNormal,
+		Amount:          uint64(defaultAmount),
+		RecipientID:     defaultRecipient,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+	}
+
+	if val, err := transaction.Verify(); val || err == nil {
+		t.Errorf("Transaction.Verify(unsigned)=%v,%v; want %v,error", val, err, false)
+	}
+
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+	if val, err := transaction.Verify(); !val || err != nil {
+		t.Errorf("Transaction.Verify()=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	// Signing again must not sign over the previous signature
+	transaction.SecondSign(crypto.NewPassphraseSigner(defaultSecondSecret))
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+	if val, err := transaction.Verify(); !val || err != nil {
+		t.Errorf("Transaction.Verify(re-signed)=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	transaction.Amount++
+	if val, err := transaction.Verify(); val || err != nil {
+		t.Errorf("Transaction.Verify(modified)=%v,%v; want %v,%v", val, err, false, nil)
+	}
+}
+
+func TestTransaction_VerifySecondSignature(t *testing.T) {
+	secondPublicKey := crypto.GetPublicKeyFromSecret(defaultSecondSecret)
+	transaction := &Transaction{
+		Type:            TransactionTypeNormal,
+		Amount:          uint64(defaultAmount),
+		RecipientID:     defaultRecipient,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+	}
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+
+	if val, err := transaction.VerifySecondSignature(secondPublicKey); val || err == nil {
+		t.Errorf("Transaction.VerifySecondSignature(missing)=%v,%v; want %v,error", val, err, false)
+	}
+
+	transaction.SecondSign(crypto.NewPassphraseSigner(defaultSecondSecret))
+	if val, err := transaction.VerifySecondSignature(secondPublicKey); !val || err != nil {
+		t.Errorf("Transaction.VerifySecondSignature()=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	if val, err := transaction.VerifySecondSignature(defaultSenderPublicKey); val || err != nil {
+		t.Errorf("Transaction.VerifySecondSignature(wrong key)=%v,%v; want %v,%v", val, err, false, nil)
+	}
+
+	if val, err := transaction.VerifySecondSignature([]byte("abc")); val || err == nil {
+		t.Errorf("Transaction.VerifySecondSignature(invalid key)=%v,%v; want %v,error", val, err, false)
+	}
+
+	if !bytes.Equal(transaction.Signature(), transaction.signature) ||
+		!bytes.Equal(transaction.SecondSignature(), transaction.secondSignature) {
+		t.Errorf("Transaction.Signature(),SecondSignature() return wrong data")
+	}
+}
+
+func TestTransaction_VerifyMultisignatures(t *testing.T) {
+	secrets := []string{"member one", "member two", "member three"}
+	var keysgroup, signatures [][]byte
+
+	transaction := &Transaction{
+		Type:            TransactionTypeNormal,
+		Amount:          uint64(defaultAmount),
+		RecipientID:     defaultRecipient,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+	}
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+
+	hash, _ := transaction.signatureHash()
+	for _, secret := range secrets {
+		keysgroup = append(keysgroup, crypto.GetPublicKeyFromSecret(secret))
+		signatures = append(signatures, crypto.SignDataWithPrivateKey(hash, crypto.GetPrivateKeyFromSecret(secret)))
+	}
+
+	if val, err := transaction.VerifyMultisignature(keysgroup[0], signatures[0]); !val || err != nil {
+		t.Errorf("Transaction.VerifyMultisignature()=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	if val, err := transaction.VerifyMultisignature(keysgroup[1], signatures[0]); val || err != nil {
+		t.Errorf("Transaction.VerifyMultisignature(wrong key)=%v,%v; want %v,%v", val, err, false, nil)
+	}
+
+	if val, err := transaction.VerifyMultisignatures(keysgroup, 2, signatures[:2]); !val || err != nil {
+		t.Errorf("Transaction.VerifyMultisignatures(2 of 2)=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	// Duplicate signatures of the same member must only count once
+	if val, err := transaction.VerifyMultisignatures(keysgroup, 2, [][]byte{signatures[0], signatures[0]}); val || err != nil {
+		t.Errorf("Transaction.VerifyMultisignatures(duplicates)=%v,%v; want %v,%v", val, err, false, nil)
+	}
+
+	if val, err := transaction.VerifyMultisignatures(keysgroup[1:], 2, signatures); !val || err != nil {
+		t.Errorf("Transaction.VerifyMultisignatures(foreign signature)=%v,%v; want %v,%v", val, err, true, nil)
+	}
+
+	if val, err := transaction.VerifyMultisignatures(keysgroup, 0, signatures); val || err == nil {
+		t.Errorf("Transaction.VerifyMultisignatures(min 0)=%v,%v; want %v,error", val, err, false)
+	}
+}
+
+func TestTransaction_Multisign(t *testing.T) {
+	members := []crypto.Signer{crypto.NewPassphraseSigner("member one"), crypto.NewPassphraseSigner("member two")}
+
+	transaction := &Transaction{
+		Type:            TransactionTypeNormal,
+		Amount:          uint64(defaultAmount),
+		RecipientID:     defaultRecipient,
+		Timestamp:       uint32(defaultTimestamp),
+		SenderPublicKey: defaultSenderPublicKey,
+	}
+
+	if val, err := transaction.Multisign(members[0]); err == nil {
+		t.Errorf("Transaction.Multisign(unsigned)=%v,%v; want nil,error", val, err)
+	}
+
+	transaction.Sign(crypto.NewPassphraseSigner(defaultSecret))
+	id, _ := transaction.ID()
+
+	for _, member := range members {
+		if _, err := transaction.Multisign(member); err != nil {
+			t.Errorf("Transaction.Multisign() returns error: %v", err)
+		}
+	}
+	transaction.Multisign(members[0])
+
+	if val := len(transaction.Signatures()); val != len(members) {
+		t.Errorf("Transaction.Signatures() returns %d signatures; want %d", val, len(members))
+	}
+
+	if val, err := transaction.ID(); val != id || err != nil {
+		t.Errorf("Transaction.ID() after Multisign()=%v,%v; want %v,%v", val, err, id, nil)
+	}
+
+	if err := transaction.AddMultisignature(members[1].PublicKey(), transaction.Signatures()[0]); err == nil {
+		t.Errorf("Transaction.AddMultisignature(wrong key) returns no error; expected error")
+	}
+
+	data, _ := json.Marshal(transaction)
+	result := &Transaction{}
+	if err := json.Unmarshal(data, result); err != nil || len(result.Signatures()) != len(members) ||
+		!bytes.Equal(result.Signatures()[1], transaction.Signatures()[1]) {
+		t.Errorf("Transaction.UnmarshalJSON() returns wrong signatures: %v,%v; want %v", result.Signatures(), err, transaction.Signatures())
+	}
+}
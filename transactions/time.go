@@ -3,6 +3,8 @@ package transactions
 import (
 	"math"
 	"time"
+
+	"github.com/liskascend/lisk-go/network"
 )
 
 // GetCurrentTimeWithOffset returns the current blockchain time with an offset
@@ -10,6 +12,11 @@ func GetCurrentTimeWithOffset(offset int64) uint32 {
 	return getTimeWithOffset(time.Now().UTC().UnixNano()/int64(time.Millisecond), offset)
 }
 
+// GetCurrentNetworkTimeWithOffset returns the current blockchain time of the network with an offset
+func GetCurrentNetworkTimeWithOffset(net *network.Network, offset int64) uint32 {
+	return net.GetTimestamp(time.Now().UTC().Add(time.Duration(offset) * time.Second))
+}
+
 func getTimeWithOffset(timestamp, offset int64) uint32 {
 	timeWithOffset := timestamp + offset*1000
 	return getTimeFromBlockchainEpoch(timeWithOffset)
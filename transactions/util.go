@@ -3,9 +3,22 @@ package transactions
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/liskascend/lisk-go/crypto"
+	"github.com/liskascend/lisk-go/network"
+	"golang.org/x/crypto/ed25519"
+)
+
+var (
+	addressPattern  = regexp.MustCompile(`^[0-9]{1,21}[Ll]$`)
+	usernamePattern = regexp.MustCompile(`^[a-z0-9!@$&_.]+$`)
+	idPattern       = regexp.MustCompile(`^[0-9]+$`)
 )
 
 // Hash returns the SHA256 hash of the transaction bytes
@@ -30,38 +43,47 @@ func (t *Transaction) ID() (string, error) {
 	return crypto.GetBigNumberStringFromBytes(crypto.GetFirstEightBytesReversed(hash)), nil
 }
 
-// Fee returns the calculated fee of the transaction
-func (t *Transaction) Fee() (uint32, error) {
+// Fee returns the calculated fee of the transaction according to the fees of its network
+func (t *Transaction) Fee() (uint64, error) {
+	fees := t.getNetwork().GetFees()
 
 	switch t.Type {
 	case TransactionTypeNormal:
 		if t.Asset != nil {
-			return feeSend + feeData, nil
+			return fees.Send + fees.Data, nil
 		}
-		return feeSend, nil
+		return fees.Send, nil
 	case TransactionTypeSecondSecretRegistration:
-		return feeSignature, nil
+		return fees.SecondSignature, nil
 	case TransactionTypeDelegateRegistration:
-		return feeDelegate, nil
+		return fees.Delegate, nil
 	case TransactionTypeVote:
-		return feeVote, nil
+		return fees.Vote, nil
 	case TransactionTypeMultisignatureRegistration:
 		if asset, hasAsset := t.Asset.(*RegisterMultisignatureAccountAsset); hasAsset {
-			return feeMultisignature * (1 + uint32(len(asset.AddKeys)+len(asset.RemoveKeys))), nil
+			return fees.Multisignature * (1 + uint64(len(asset.AddKeys)+len(asset.RemoveKeys))), nil
 		}
 
 		return 0, errors.New("invalid asset - cannot calculate fee")
 	case TransactionTypeDappRegistration:
-		return feeDapp, nil
+		return fees.DappRegistration, nil
 	case TransactionTypeTransferInSidechain:
-		return feeTransferIn, nil
+		return fees.DappDeposit, nil
 	case TransactionTypeTransferOutSidechain:
-		return feeTransferOut, nil
+		return fees.DappWithdrawal, nil
 	}
 
 	return 0, errors.New("unknown transaction type")
 }
 
+// getNetwork returns the network of the transaction or the mainnet if none is set
+func (t *Transaction) getNetwork() *network.Network {
+	if t.Network == nil {
+		return network.Mainnet
+	}
+	return t.Network
+}
+
 func bytesSliceContainsDuplicates(data [][]byte) bool {
 	for i, item := range data {
 		for j, item2 := range data {
@@ -72,3 +94,93 @@ func bytesSliceContainsDuplicates(data [][]byte) bool {
 	}
 	return false
 }
+
+func containsBytes(data [][]byte, item []byte) bool {
+	for _, element := range data {
+		if bytes.Equal(element, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSignedPublicKeys splits a list of hex encoded public keys prefixed with "+" or "-"
+// (as used for votes and keysgroups) into the added and the removed keys.
+func parseSignedPublicKeys(entries []string) ([][]byte, [][]byte, error) {
+	var added, removed [][]byte
+	for _, entry := range entries {
+		if len(entry) == 0 {
+			return nil, nil, errors.New("empty public key entry")
+		}
+
+		key, err := hex.DecodeString(entry[1:])
+		if err != nil {
+			return nil, nil, fmt.Errorf("public key %s is not valid hex: %v", entry[1:], err)
+		}
+
+		switch entry[0] {
+		case '+':
+			added = append(added, key)
+		case '-':
+			removed = append(removed, key)
+		default:
+			return nil, nil, fmt.Errorf("public key %s is not prefixed with + or -", entry)
+		}
+	}
+	return added, removed, nil
+}
+
+// splitSignedPublicKeys splits the binary representation of a vote or keysgroup list into its entries
+func splitSignedPublicKeys(data []byte) ([]string, error) {
+	entrySize := 1 + 2*ed25519.PublicKeySize
+	if len(data)%entrySize != 0 {
+		return nil, fmt.Errorf("invalid public key list length %d", len(data))
+	}
+
+	var entries []string
+	for i := 0; i < len(data); i += entrySize {
+		entries = append(entries, string(data[i:i+entrySize]))
+	}
+	return entries, nil
+}
+
+// decodeOptionalHex decodes a hex string and returns nil for empty strings
+func decodeOptionalHex(data string) ([]byte, error) {
+	if data == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(data)
+}
+
+// isValidID returns whether the string is a valid ID of a transaction or block
+func isValidID(id string) bool {
+	return len(id) <= maxIDLength && idPattern.MatchString(id)
+}
+
+// isValidURL returns whether the string is an absolute URL with one of the given file extensions
+func isValidURL(rawURL string, extensions ...string) bool {
+	if len(rawURL) > maxDappURLLength {
+		return false
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return false
+	}
+
+	for _, extension := range extensions {
+		if strings.HasSuffix(rawURL, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(data []string, item string) bool {
+	for _, element := range data {
+		if element == item {
+			return true
+		}
+	}
+	return false
+}
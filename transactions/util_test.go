@@ -3,6 +3,8 @@ package transactions
 import (
 	"encoding/base64"
 	"testing"
+
+	"github.com/liskascend/lisk-go/network"
 )
 
 var (
@@ -120,4 +122,13 @@ func TestTransaction_Fee(t *testing.T) {
 	}).Fee(); err == nil {
 		t.Errorf("Transaction.Fee() returns wrong data: %v,%v; expected error", val, err)
 	}
+
+	devnet := network.NewCustomNetwork("devnet", "", network.DefaultEpoch, nil)
+	devnet.Fees = &network.FeeSchedule{Send: 1}
+	if val, err := (&Transaction{
+		Type:    0,
+		Network: devnet,
+	}).Fee(); val != 1 || err != nil {
+		t.Errorf("Transaction.Fee() with custom network returns wrong data: %v,%v; want %v", val, err, 1)
+	}
 }
@@ -0,0 +1,220 @@
+package transactions
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/liskascend/lisk-go/crypto"
+	"golang.org/x/crypto/ed25519"
+)
+
+type (
+	// ValidationError is a violated validation rule of a field of a transaction
+	ValidationError struct {
+		// Field is the path of the field in the JSON format of the transaction, e.g. asset.delegate.username
+		Field string
+		// Message describes the violated rule
+		Message string
+	}
+
+	// ValidationErrors are all violated validation rules of a transaction
+	ValidationErrors []*ValidationError
+)
+
+var (
+	maxAddressNumber = new(big.Int).SetUint64(^uint64(0))
+)
+
+// Error returns the field and the message of the error
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Error returns all errors separated by semicolons
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Field returns the errors of the given field and its subfields
+func (e ValidationErrors) Field(field string) ValidationErrors {
+	var result ValidationErrors
+	for _, err := range e {
+		if err.Field == field || strings.HasPrefix(err.Field, field+".") {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// add appends an error for the field
+func (e *ValidationErrors) add(field string, format string, args ...interface{}) {
+	*e = append(*e, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate checks the transaction against the schema and the rules applied by Lisk Core
+// and returns all violations. It returns nil if the transaction is valid.
+func (t *Transaction) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	if len(t.SenderPublicKey) != ed25519.PublicKeySize {
+		errs.add("senderPublicKey", "invalid or missing public key")
+	}
+
+	if len(t.TransactionRequesterPublicKey) != 0 && len(t.TransactionRequesterPublicKey) != ed25519.PublicKeySize {
+		errs.add("transactionRequesterPublicKey", "invalid public key size")
+	}
+
+	if t.Type > TransactionTypeTransferOutSidechain {
+		errs.add("type", "invalid transaction type")
+	}
+
+	if t.Amount > maxAmount {
+		errs.add("amount", "exceeds the total supply of %d", uint64(maxAmount))
+	}
+
+	if t.RecipientID != "" && !isValidAddress(t.RecipientID) {
+		errs.add("recipientId", "invalid address format")
+	}
+
+	if !t.hasValidAssetType() {
+		errs.add("asset", "invalid asset type or missing asset")
+	} else if t.Asset != nil {
+		errs = append(errs, t.Asset.validate()...)
+	}
+
+	switch t.Type {
+	case TransactionTypeNormal:
+		if t.Amount == 0 {
+			errs.add("amount", "must be greater than 0")
+		}
+		if t.RecipientID == "" {
+			errs.add("recipientId", "must not be empty")
+		}
+	case TransactionTypeVote:
+		if t.Amount > 0 {
+			errs.add("amount", "must be 0")
+		}
+		if len(t.SenderPublicKey) == ed25519.PublicKeySize &&
+			t.RecipientID != crypto.GetAddressFromPublicKey(t.SenderPublicKey) {
+			errs.add("recipientId", "must be the address of the sender")
+		}
+		if asset, ok := t.Asset.(*CastVoteAsset); ok && len(asset.Votes)+len(asset.Unvotes) == 0 {
+			errs.add("asset.votes", "must contain at least one vote or unvote")
+		}
+	case TransactionTypeMultisignatureRegistration:
+		if t.Amount > 0 {
+			errs.add("amount", "must be 0")
+		}
+		if t.RecipientID != "" {
+			errs.add("recipientId", "must be empty")
+		}
+		if asset, ok := t.Asset.(*RegisterMultisignatureAccountAsset); ok {
+			if len(asset.RemoveKeys) > 0 {
+				errs.add("asset.multisignature.keysgroup", "must only contain keys to add")
+			}
+			for _, key := range asset.AddKeys {
+				if bytes.Equal(key, t.SenderPublicKey) {
+					errs.add("asset.multisignature.keysgroup", "must not contain the public key of the sender")
+					break
+				}
+			}
+		}
+	case TransactionTypeSecondSecretRegistration, TransactionTypeDelegateRegistration,
+		TransactionTypeDappRegistration:
+		if t.Amount > 0 {
+			errs.add("amount", "must be 0")
+		}
+		if t.RecipientID != "" {
+			errs.add("recipientId", "must be empty")
+		}
+	case TransactionTypeTransferInSidechain:
+		if t.Amount == 0 {
+			errs.add("amount", "must be greater than 0")
+		}
+		if t.RecipientID != "" {
+			errs.add("recipientId", "must be empty")
+		}
+	case TransactionTypeTransferOutSidechain:
+		if t.Amount == 0 {
+			errs.add("amount", "must be greater than 0")
+		}
+		if t.RecipientID == "" {
+			errs.add("recipientId", "must not be empty")
+		}
+	}
+
+	if len(t.signature) != 0 && len(t.signature) != byteSizeSignatureTransaction {
+		errs.add("signature", "invalid signature size")
+	}
+
+	if len(t.secondSignature) != 0 && len(t.secondSignature) != byteSizeSecondSignatureTransaction {
+		errs.add("secondSignature", "invalid signature size")
+	}
+
+	for _, signature := range t.signatures {
+		if len(signature) != byteSizeSignatureTransaction {
+			errs.add("signatures", "contains a signature with invalid size")
+			break
+		}
+	}
+
+	return errs
+}
+
+// hasValidAssetType returns whether the asset has the type required by the transaction type
+func (t *Transaction) hasValidAssetType() bool {
+	var valid bool
+
+	switch t.Type {
+	case TransactionTypeNormal:
+		_, valid = t.Asset.(DataAsset)
+		return t.Asset == nil || valid
+	case TransactionTypeSecondSecretRegistration:
+		_, valid = t.Asset.(*RegisterSecondSignatureAsset)
+	case TransactionTypeDelegateRegistration:
+		_, valid = t.Asset.(*RegisterDelegateAsset)
+	case TransactionTypeVote:
+		_, valid = t.Asset.(*CastVoteAsset)
+	case TransactionTypeMultisignatureRegistration:
+		_, valid = t.Asset.(*RegisterMultisignatureAccountAsset)
+	case TransactionTypeDappRegistration:
+		_, valid = t.Asset.(*CreateDappAsset)
+	case TransactionTypeTransferInSidechain:
+		_, valid = t.Asset.(*TransferInDappAsset)
+	case TransactionTypeTransferOutSidechain:
+		_, valid = t.Asset.(*TransferOutDappAsset)
+	default:
+		return true
+	}
+
+	return valid
+}
+
+// validationResult converts the errors to the result of an IsValid method
+func validationResult(errs ValidationErrors) (bool, error) {
+	if len(errs) > 0 {
+		return false, errs
+	}
+	return true, nil
+}
+
+// isValidAddress returns whether the string is a Lisk address
+func isValidAddress(address string) bool {
+	if !strings.HasSuffix(address, "L") || len(address) < 2 || len(address) > 21 {
+		return false
+	}
+
+	number := address[:len(address)-1]
+	if !idPattern.MatchString(number) {
+		return false
+	}
+
+	value, ok := new(big.Int).SetString(number, 10)
+	return ok && value.Cmp(maxAddressNumber) <= 0
+}
@@ -0,0 +1,100 @@
+package transactions
+
+import (
+	"testing"
+)
+
+func TestTransaction_Validate(t *testing.T) {
+	tests := []struct {
+		in     *Transaction
+		fields []string
+	}{
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: defaultRecipient}, nil},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: "18446744073709551615L"}, nil},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: "18446744073709551616L"}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: "58191285901858109l"}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: "L"}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1, RecipientID: "1234"}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeNormal, Amount: maxAmount, RecipientID: defaultRecipient}, nil},
+		{&Transaction{Type: TransactionTypeNormal, Amount: maxAmount + 1, RecipientID: defaultRecipient}, []string{"amount"}},
+		{&Transaction{Type: TransactionTypeSecondSecretRegistration, RecipientID: defaultRecipient,
+			Asset: &RegisterSecondSignatureAsset{PublicKey: defaultSenderSecondPublicKey}}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeSecondSecretRegistration, Amount: 1,
+			Asset: &RegisterSecondSignatureAsset{}}, []string{"asset.signature.publicKey", "amount"}},
+		{&Transaction{Type: TransactionTypeDelegateRegistration,
+			Asset: &RegisterDelegateAsset{Username: "Genesis", PublicKey: defaultSenderPublicKey}},
+			[]string{"asset.delegate.username"}},
+		{&Transaction{Type: TransactionTypeMultisignatureRegistration,
+			Asset: &RegisterMultisignatureAccountAsset{AddKeys: [][]byte{defaultMultisignaturePublicKey}}},
+			[]string{"asset.multisignature.min", "asset.multisignature.lifetime"}},
+		{&Transaction{Type: TransactionTypeVote, RecipientID: defaultSenderId, Asset: DataAsset("abc")}, []string{"asset"}},
+		{&Transaction{Type: TransactionTypeNormal, RecipientID: defaultRecipient}, []string{"amount"}},
+		{&Transaction{Type: TransactionTypeNormal, Amount: 1}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeVote, RecipientID: defaultSenderId,
+			Asset: &CastVoteAsset{Votes: [][]byte{defaultSenderSecondPublicKey}}}, nil},
+		{&Transaction{Type: TransactionTypeVote, RecipientID: defaultRecipient,
+			Asset: &CastVoteAsset{Votes: [][]byte{defaultSenderSecondPublicKey}}}, []string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeVote, Asset: &CastVoteAsset{Unvotes: [][]byte{defaultSenderSecondPublicKey}}},
+			[]string{"recipientId"}},
+		{&Transaction{Type: TransactionTypeVote, RecipientID: defaultSenderId, Asset: &CastVoteAsset{}},
+			[]string{"asset.votes"}},
+		{&Transaction{Type: TransactionTypeVote, Amount: 1, RecipientID: defaultSenderId,
+			Asset: &CastVoteAsset{Votes: [][]byte{defaultSenderSecondPublicKey}}}, []string{"amount"}},
+		{&Transaction{Type: TransactionTypeMultisignatureRegistration,
+			Asset: &RegisterMultisignatureAccountAsset{AddKeys: [][]byte{defaultMultisignaturePublicKey}, Min: 1,
+				Lifetime: 24}}, nil},
+		{&Transaction{Type: TransactionTypeMultisignatureRegistration, Amount: 1, RecipientID: defaultRecipient,
+			Asset: &RegisterMultisignatureAccountAsset{AddKeys: [][]byte{defaultMultisignaturePublicKey}, Min: 1,
+				Lifetime: 24}}, []string{"amount", "recipientId"}},
+		{&Transaction{Type: TransactionTypeMultisignatureRegistration,
+			Asset: &RegisterMultisignatureAccountAsset{AddKeys: [][]byte{defaultMultisignaturePublicKey},
+				RemoveKeys: [][]byte{defaultSenderSecondPublicKey}, Min: 1, Lifetime: 24}},
+			[]string{"asset.multisignature.keysgroup"}},
+		{&Transaction{Type: TransactionTypeMultisignatureRegistration,
+			Asset: &RegisterMultisignatureAccountAsset{AddKeys: [][]byte{defaultSenderPublicKey}, Min: 1,
+				Lifetime: 24}}, []string{"asset.multisignature.keysgroup"}},
+		{&Transaction{Type: 8}, []string{"type"}},
+	}
+
+	for i, test := range tests {
+		test.in.SenderPublicKey = defaultSenderPublicKey
+
+		errs := test.in.Validate()
+		if len(errs) != len(test.fields) {
+			t.Errorf("#%d: Transaction.Validate() returns %v; want errors for %v", i, errs, test.fields)
+			continue
+		}
+
+		for j, field := range test.fields {
+			if errs[j].Field != field {
+				t.Errorf("#%d: Transaction.Validate() returns error for field %s; want %s", i, errs[j].Field, field)
+			}
+		}
+	}
+}
+
+func TestTransaction_IsValidReturnsValidationErrors(t *testing.T) {
+	transaction := &Transaction{
+		Type:   TransactionTypeDappRegistration,
+		Amount: 1,
+		Asset:  &CreateDappAsset{Dapp: &Dapp{Name: " a", Link: "https://a.io/a.tar"}},
+	}
+
+	valid, err := transaction.IsValid()
+	errs, ok := err.(ValidationErrors)
+	if valid || !ok {
+		t.Fatalf("Transaction.IsValid() returns %v,%v; want ValidationErrors", valid, err)
+	}
+
+	if len(errs.Field("asset.dapp")) != 2 || len(errs.Field("senderPublicKey")) != 1 ||
+		len(errs.Field("amount")) != 1 || len(errs.Field("asset.dapp.link")) != 1 {
+		t.Errorf("Transaction.IsValid() returns wrong errors: %v", errs)
+	}
+
+	transaction.Amount = 0
+	transaction.SenderPublicKey = defaultSenderPublicKey
+	transaction.Asset = &CreateDappAsset{Dapp: &Dapp{Name: "a", Link: "https://a.io/a.zip"}}
+	if valid, err := transaction.IsValid(); !valid || err != nil {
+		t.Errorf("Transaction.IsValid() returns %v,%v; want true,nil", valid, err)
+	}
+}